@@ -0,0 +1,143 @@
+package api
+
+import (
+	"errors"
+	xnet "net"
+	"net/http"
+	"time"
+	"xcore/auth"
+	"xcore/core/totp"
+)
+
+const maxPasswordLength = 16
+
+var (
+	errRegistrationDisabled = errors.New("registration is disabled")
+	errInvalidPassword      = errors.New("password must be 1-16 characters")
+	errWrongCredentials     = errors.New("wrong account name, password or authenticator code")
+	errLockedOut            = errors.New("too many failed attempts, try again later")
+)
+
+type registerRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+type changePasswordRequest struct {
+	Name        string `json:"name"`
+	Password    string `json:"password"`
+	NewPassword string `json:"new_password"`
+	// TotpCode is required if account has authenticator enabled.
+	TotpCode string `json:"totp_code"`
+}
+
+type accountResponse struct {
+	Name string `json:"name"`
+}
+
+func (srv *server) handleRegister(w http.ResponseWriter, r *http.Request) {
+	if !srv.config.HTTPAPI.AllowRegistration {
+		writeError(w, http.StatusForbidden, errRegistrationDisabled)
+		return
+	}
+
+	var req registerRequest
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// every registration counts, so one address can not create accounts in bulk or probe names
+	address := remoteIP(r)
+	if srv.limiter.IsLocked(req.Name, address) {
+		writeError(w, http.StatusTooManyRequests, errLockedOut)
+		return
+	}
+	if srv.limiter.RegisterAttempt(address) {
+		srv.logger.Warnf("address %v locked out after registrations", address)
+	}
+
+	if !isValidPassword(req.Password) {
+		writeError(w, http.StatusBadRequest, errInvalidPassword)
+		return
+	}
+
+	if err := srv.accRepo.CreateAccount(req.Name, req.Password); err != nil {
+		switch err {
+		case auth.ErrInvalidAccountName:
+			writeError(w, http.StatusBadRequest, err)
+		case auth.ErrAccountExists:
+			writeError(w, http.StatusConflict, err)
+		default:
+			srv.writeInternalError(w, err)
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, accountResponse{Name: req.Name})
+}
+
+func (srv *server) handleChangePassword(w http.ResponseWriter, r *http.Request) {
+	var req changePasswordRequest
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if !isValidPassword(req.NewPassword) {
+		writeError(w, http.StatusBadRequest, errInvalidPassword)
+		return
+	}
+
+	address := remoteIP(r)
+	if srv.limiter.IsLocked(req.Name, address) {
+		writeError(w, http.StatusTooManyRequests, errLockedOut)
+		return
+	}
+
+	acc, err := srv.accRepo.VerifyAccountPassword(req.Name, req.Password)
+	if err != nil {
+		srv.writeInternalError(w, err)
+		return
+	}
+	valid := acc != nil
+	if valid && acc.HasTotpSecret() {
+		// each code is accepted only once, replayed code fails like a wrong one
+		step, ok := totp.Validate(acc.TotpSecret.String, req.TotpCode, time.Now())
+		if valid = ok; ok {
+			if valid, err = srv.accRepo.AcceptTotpStep(acc.ID, step); err != nil {
+				srv.writeInternalError(w, err)
+				return
+			}
+		}
+	}
+
+	// the same error is returned for wrong password and code, so the password can not be probed alone
+	if !valid {
+		if srv.limiter.RegisterFailure(req.Name, address) {
+			srv.logger.Warnf("account %v and address %v locked out after failed password changes", req.Name, address)
+		}
+		writeError(w, http.StatusUnauthorized, errWrongCredentials)
+		return
+	}
+	srv.limiter.Reset(req.Name)
+
+	if err := srv.accRepo.SetAccountPassword(acc.Name, req.NewPassword); err != nil {
+		srv.writeInternalError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, accountResponse{Name: acc.Name})
+}
+
+// remoteIP returns address of client without port.
+func remoteIP(r *http.Request) string {
+	host, _, err := xnet.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func isValidPassword(password string) bool {
+	return len(password) > 0 && len(password) <= maxPasswordLength
+}
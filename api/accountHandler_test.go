@@ -0,0 +1,119 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+	"xcore/core/totp"
+)
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name string
+		req  interface{}
+		want int
+	}{
+		{"created", registerRequest{Name: "newplayer", Password: "pass"}, http.StatusCreated},
+		{"exists", registerRequest{Name: "PLAYER", Password: "pass"}, http.StatusConflict},
+		{"invalid name", registerRequest{Name: "bad name", Password: "pass"}, http.StatusBadRequest},
+		{"empty password", registerRequest{Name: "other"}, http.StatusBadRequest},
+		{"long password", registerRequest{Name: "other", Password: "12345678901234567"}, http.StatusBadRequest},
+		{"malformed", "not an object", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTestServer(t)
+			srv.mustCreateAccount(t, "player", "pass")
+			assertStatus(t, srv.do(t, http.MethodPost, "/api/accounts", tt.req), tt.want)
+		})
+	}
+}
+
+func TestRegisterDisabled(t *testing.T) {
+	srv := newTestServer(t)
+	srv.config.HTTPAPI.AllowRegistration = false
+
+	assertStatus(t, srv.do(t, http.MethodPost, "/api/accounts", registerRequest{Name: "player", Password: "pass"}), http.StatusForbidden)
+	if acc, _ := srv.accRepo.GetAccountWithName("player"); acc != nil {
+		t.Fatal("account created while registration is disabled")
+	}
+}
+
+func TestRegisterIsThrottled(t *testing.T) {
+	srv := newTestServer(t)
+	names := []string{"first", "second", "third", "fourth"}
+	for _, name := range names[:srv.config.Lockout.MaxFailedAttempts] {
+		assertStatus(t, srv.do(t, http.MethodPost, "/api/accounts", registerRequest{Name: name, Password: "pass"}), http.StatusCreated)
+	}
+
+	last := names[srv.config.Lockout.MaxFailedAttempts]
+	assertStatus(t, srv.do(t, http.MethodPost, "/api/accounts", registerRequest{Name: last, Password: "pass"}), http.StatusTooManyRequests)
+	if acc, _ := srv.accRepo.GetAccountWithName(last); acc != nil {
+		t.Fatal("account created by locked out address")
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	tests := []struct {
+		name string
+		req  changePasswordRequest
+		want int
+	}{
+		{"changed", changePasswordRequest{Name: "player", Password: "pass", NewPassword: "new"}, http.StatusOK},
+		{"wrong password", changePasswordRequest{Name: "player", Password: "wrong", NewPassword: "new"}, http.StatusUnauthorized},
+		{"unknown account", changePasswordRequest{Name: "nobody", Password: "pass", NewPassword: "new"}, http.StatusUnauthorized},
+		{"invalid new password", changePasswordRequest{Name: "player", Password: "pass"}, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTestServer(t)
+			srv.mustCreateAccount(t, "player", "pass")
+
+			assertStatus(t, srv.do(t, http.MethodPost, "/api/accounts/password", tt.req), tt.want)
+
+			changed := srv.accRepo.(*fakeAccountRepository).passwords["PLAYER"] == "new"
+			if changed != (tt.want == http.StatusOK) {
+				t.Fatalf("password changed: %v", changed)
+			}
+		})
+	}
+}
+
+func TestChangePasswordLockout(t *testing.T) {
+	srv := newTestServer(t)
+	srv.mustCreateAccount(t, "player", "pass")
+
+	for i := 0; i < srv.config.Lockout.MaxFailedAttempts; i++ {
+		req := changePasswordRequest{Name: "player", Password: "wrong", NewPassword: "new"}
+		assertStatus(t, srv.do(t, http.MethodPost, "/api/accounts/password", req), http.StatusUnauthorized)
+	}
+
+	// correct password does not help once locked out
+	req := changePasswordRequest{Name: "player", Password: "pass", NewPassword: "new"}
+	assertStatus(t, srv.do(t, http.MethodPost, "/api/accounts/password", req), http.StatusTooManyRequests)
+}
+
+func TestChangePasswordWithAuthenticator(t *testing.T) {
+	srv := newTestServer(t)
+	acc := srv.mustCreateAccount(t, "player", "pass")
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	acc.TotpSecret = sql.NullString{String: secret, Valid: true}
+
+	code, err := totp.GenerateCode(secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	missing := changePasswordRequest{Name: "player", Password: "pass", NewPassword: "new"}
+	assertStatus(t, srv.do(t, http.MethodPost, "/api/accounts/password", missing), http.StatusUnauthorized)
+
+	valid := changePasswordRequest{Name: "player", Password: "pass", NewPassword: "new", TotpCode: code}
+	assertStatus(t, srv.do(t, http.MethodPost, "/api/accounts/password", valid), http.StatusOK)
+
+	replayed := changePasswordRequest{Name: "player", Password: "new", NewPassword: "newer", TotpCode: code}
+	assertStatus(t, srv.do(t, http.MethodPost, "/api/accounts/password", replayed), http.StatusUnauthorized)
+}
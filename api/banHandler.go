@@ -0,0 +1,112 @@
+package api
+
+import (
+	"net/http"
+	"time"
+	"xcore/auth"
+)
+
+const banAuthor = "http api"
+
+type banRequest struct {
+	Account string `json:"account"`
+	Address string `json:"address"`
+	Reason  string `json:"reason"`
+	// Duration is Go duration string like `72h`, empty for permanent ban.
+	Duration string `json:"duration"`
+}
+
+type banResponse struct {
+	Account string `json:"account,omitempty"`
+	Address string `json:"address,omitempty"`
+	Banned  bool   `json:"banned"`
+}
+
+// handleAccountBan bans account and kicks its session on POST and lifts its ban on DELETE.
+func (srv *server) handleAccountBan(w http.ResponseWriter, r *http.Request) {
+	var req banRequest
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	acc, err := srv.accRepo.GetAccountWithName(req.Account)
+	if err != nil {
+		srv.writeInternalError(w, err)
+		return
+	}
+	if acc == nil {
+		writeError(w, http.StatusNotFound, auth.ErrAccountNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		duration, err := parseBanDuration(req.Duration)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		if err = srv.banRepo.BanAccount(acc.ID, req.Reason, banAuthor, duration); err == nil {
+			// world server closes session of banned account
+			err = srv.onlineRepo.RequestKick(acc.ID)
+		}
+	case http.MethodDelete:
+		err = srv.banRepo.UnbanAccount(acc.ID)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+		return
+	}
+
+	if err != nil {
+		srv.writeInternalError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, banResponse{Account: acc.Name, Banned: r.Method == http.MethodPost})
+}
+
+// handleAddressBan bans address or CIDR range on POST and lifts its ban on DELETE.
+func (srv *server) handleAddressBan(w http.ResponseWriter, r *http.Request) {
+	var req banRequest
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var err error
+	switch r.Method {
+	case http.MethodPost:
+		duration, perr := parseBanDuration(req.Duration)
+		if perr != nil {
+			writeError(w, http.StatusBadRequest, perr)
+			return
+		}
+		err = srv.banRepo.BanAddress(req.Address, req.Reason, banAuthor, duration)
+	case http.MethodDelete:
+		err = srv.banRepo.UnbanAddress(req.Address)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+		return
+	}
+
+	if err == auth.ErrInvalidBanAddress {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err != nil {
+		srv.writeInternalError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, banResponse{Address: req.Address, Banned: r.Method == http.MethodPost})
+}
+
+func parseBanDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, errInvalidRequest
+	}
+	return d, nil
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAccountBan(t *testing.T) {
+	srv := newTestServer(t)
+	acc := srv.mustCreateAccount(t, "player", "pass")
+	bans := srv.banRepo.(*fakeBanRepository)
+	online := srv.onlineRepo.(*fakeOnlineAccountRepository)
+
+	req := banRequest{Account: "player", Reason: "cheating", Duration: "72h"}
+	assertStatus(t, srv.do(t, http.MethodPost, "/api/admin/bans/account", req), http.StatusOK)
+	if d, ok := bans.banned[acc.ID]; !ok || d != time.Hour*72 {
+		t.Fatalf("got ban %v %v, want 72h", d, ok)
+	}
+	if len(online.kicked) != 1 || online.kicked[0] != acc.ID {
+		t.Fatalf("got kicked accounts %v, want [%v]", online.kicked, acc.ID)
+	}
+
+	assertStatus(t, srv.do(t, http.MethodDelete, "/api/admin/bans/account", banRequest{Account: "player"}), http.StatusOK)
+	if _, ok := bans.banned[acc.ID]; ok {
+		t.Fatal("ban was not lifted")
+	}
+}
+
+func TestAccountBanErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		req    interface{}
+		want   int
+	}{
+		{"unknown account", http.MethodPost, banRequest{Account: "nobody"}, http.StatusNotFound},
+		{"unban unknown account", http.MethodDelete, banRequest{Account: "nobody"}, http.StatusNotFound},
+		{"invalid duration", http.MethodPost, banRequest{Account: "player", Duration: "forever"}, http.StatusBadRequest},
+		{"negative duration", http.MethodPost, banRequest{Account: "player", Duration: "-1h"}, http.StatusBadRequest},
+		{"malformed", http.MethodPost, "not an object", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTestServer(t)
+			srv.mustCreateAccount(t, "player", "pass")
+
+			assertStatus(t, srv.do(t, tt.method, "/api/admin/bans/account", tt.req), tt.want)
+			if n := len(srv.banRepo.(*fakeBanRepository).banned); n != 0 {
+				t.Fatalf("got %v bans", n)
+			}
+			if kicked := srv.onlineRepo.(*fakeOnlineAccountRepository).kicked; len(kicked) != 0 {
+				t.Fatalf("got kicked accounts %v", kicked)
+			}
+		})
+	}
+}
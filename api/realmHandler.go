@@ -0,0 +1,86 @@
+package api
+
+import (
+	"net/http"
+	"time"
+	"xcore/core/models"
+)
+
+type realmResponse struct {
+	ID         uint8  `json:"id"`
+	Name       string `json:"name"`
+	Address    string `json:"address"`
+	Version    string `json:"version"`
+	Online     bool   `json:"online"`
+	Players    uint32 `json:"players"`
+	MaxPlayers uint32 `json:"max_players"`
+}
+
+type onlineResponse struct {
+	Total  int           `json:"total"`
+	Realms map[uint8]int `json:"realms"`
+}
+
+func (srv *server) handleRealms(w http.ResponseWriter, r *http.Request) {
+	statuses, err := srv.realmStatuses()
+	if err != nil {
+		srv.writeInternalError(w, err)
+		return
+	}
+
+	realms := srv.realmList.GetRealms()
+	res := make([]realmResponse, 0, len(realms))
+	for _, realm := range realms {
+		rr := realmResponse{
+			ID:      realm.ID,
+			Name:    realm.Name,
+			Address: realm.Address,
+			Version: realm.Version,
+			Online:  !realm.Flag.Has(models.RealmFlagOffline),
+		}
+		if s := statuses[realm.ID]; s != nil && rr.Online {
+			rr.Players = s.PlayersCount
+			rr.MaxPlayers = s.MaxPlayers
+		}
+		res = append(res, rr)
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (srv *server) handleOnline(w http.ResponseWriter, r *http.Request) {
+	statuses, err := srv.realmStatuses()
+	if err != nil {
+		srv.writeInternalError(w, err)
+		return
+	}
+
+	res := onlineResponse{Realms: map[uint8]int{}}
+	for id := range statuses {
+		accounts, err := srv.onlineRepo.GetOnlineAccounts(id)
+		if err != nil {
+			srv.writeInternalError(w, err)
+			return
+		}
+		res.Realms[id] = len(accounts)
+		res.Total += len(accounts)
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// realmStatuses returns statuses of realms which world servers are sending heartbeats.
+func (srv *server) realmStatuses() (map[uint8]*models.RealmStatus, error) {
+	statuses, err := srv.statusRepo.GetRealmStatuses()
+	if err != nil {
+		return nil, err
+	}
+
+	online := make(map[uint8]*models.RealmStatus, len(statuses))
+	for _, s := range statuses {
+		if time.Since(s.HeartbeatAt) <= srv.config.World.HeartbeatTimeout {
+			online[s.RealmID] = s
+		}
+	}
+	return online, nil
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+	"xcore/core/models"
+)
+
+func TestRealms(t *testing.T) {
+	srv := newTestServer(t)
+	srv.realmList.(*fakeRealmProvider).realms = []*models.Realm{
+		{ID: 1, Name: "Online", Address: "127.0.0.1:8085", Version: "2.4.3.8606"},
+		{ID: 2, Name: "Offline", Address: "127.0.0.1:8086", Version: "2.4.3.8606", Flag: models.RealmFlagOffline},
+	}
+	srv.statusRepo.(*fakeRealmStatusRepository).statuses = []*models.RealmStatus{
+		{RealmID: 1, PlayersCount: 5, MaxPlayers: 100, HeartbeatAt: time.Now()},
+		// provider marked realm offline, stale status must not be listed
+		{RealmID: 2, PlayersCount: 7, MaxPlayers: 100, HeartbeatAt: time.Now()},
+	}
+
+	w := srv.do(t, http.MethodGet, "/api/realms", nil)
+	assertStatus(t, w, http.StatusOK)
+
+	var got []realmResponse
+	if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	want := []realmResponse{
+		{ID: 1, Name: "Online", Address: "127.0.0.1:8085", Version: "2.4.3.8606", Online: true, Players: 5, MaxPlayers: 100},
+		{ID: 2, Name: "Offline", Address: "127.0.0.1:8086", Version: "2.4.3.8606"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+var (
+	errMethodNotAllowed = errors.New("method not allowed")
+	errAdminDisabled    = errors.New("admin api is disabled")
+	errUnauthorized     = errors.New("unauthorized")
+	errInvalidRequest   = errors.New("invalid request")
+	errInternal         = errors.New("internal error")
+)
+
+const maxRequestSize = 1 << 16
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func readJSON(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestSize)).Decode(v); err != nil {
+		return errInvalidRequest
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	// encoding fails only when client has gone, there is nobody to report to
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+// writeInternalError logs err and hides its details from client.
+func (srv *server) writeInternalError(w http.ResponseWriter, err error) {
+	srv.logger.Errorf("http api error: %v", err)
+	writeError(w, http.StatusInternalServerError, errInternal)
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	xnet "net"
+	"net/http"
+	"strings"
+	"time"
+	"xcore/auth"
+	"xcore/config"
+	"xcore/core/db"
+	"xcore/core/logger"
+)
+
+const shutdownTimeout = time.Second * 5
+
+// Server is embedded HTTP server with JSON API for website and bots.
+type Server interface {
+	Start() error
+	Stop() error
+}
+
+type server struct {
+	config *config.Config
+	logger logger.Logger
+
+	accRepo auth.AccountRepository
+	banRepo auth.BanRepository
+	// realmList is created like realm list of auth server, so the same realms and statuses are listed
+	realmList  auth.RealmProvider
+	statusRepo auth.RealmStatusRepository
+	onlineRepo auth.OnlineAccountRepository
+
+	// limiter throttles password changes like logon does and registrations, its counters are separate from auth server
+	limiter *auth.LoginLimiter
+
+	httpServer *http.Server
+}
+
+// NewServer creates API server, shared may be nil when it does not run with both auth and world servers.
+func NewServer(c *config.Config, l logger.Logger, shared *auth.Shared) (Server, error) {
+	var xdb *db.DB
+	var keys auth.SessionKeyStore
+	var kicks chan struct{}
+	var err error
+	if shared != nil {
+		xdb, keys, kicks = shared.DB, shared.Keys, shared.Kicks
+	} else if xdb, err = db.New(c.DBConfig); err != nil {
+		return nil, err
+	}
+
+	s := new(server)
+	s.config = c
+	s.logger = l.Subsystem("api")
+	if s.accRepo, err = auth.NewAccountRepository(c, xdb, keys); err != nil {
+		return nil, err
+	}
+	if s.banRepo, err = auth.NewBanRepository(xdb, keys); err != nil {
+		return nil, err
+	}
+	if s.realmList, err = auth.NewRealmProvider(c, xdb, s.logger); err != nil {
+		return nil, err
+	}
+	if s.statusRepo, err = auth.NewRealmStatusRepository(xdb); err != nil {
+		return nil, err
+	}
+	if s.onlineRepo, err = auth.NewOnlineAccountRepository(xdb, kicks); err != nil {
+		return nil, err
+	}
+
+	s.limiter = auth.NewLoginLimiter(c.Lockout)
+
+	s.httpServer = &http.Server{
+		Addr:         c.HTTPAPI.Address,
+		Handler:      s.handler(),
+		ReadTimeout:  time.Second * 10,
+		WriteTimeout: time.Second * 10,
+	}
+	return s, nil
+}
+
+func (srv *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/accounts", srv.allow(http.MethodPost, srv.handleRegister))
+	mux.HandleFunc("/api/accounts/password", srv.allow(http.MethodPost, srv.handleChangePassword))
+	mux.HandleFunc("/api/realms", srv.allow(http.MethodGet, srv.handleRealms))
+	mux.HandleFunc("/api/online", srv.allow(http.MethodGet, srv.handleOnline))
+	mux.HandleFunc("/api/admin/bans/account", srv.adminOnly(srv.handleAccountBan))
+	mux.HandleFunc("/api/admin/bans/address", srv.adminOnly(srv.handleAddressBan))
+	return mux
+}
+
+func (srv *server) Start() error {
+	l, err := xnet.Listen("tcp", srv.httpServer.Addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if err := srv.httpServer.Serve(l); err != nil && err != http.ErrServerClosed {
+			srv.logger.Errorf("http api error: %v", err)
+		}
+	}()
+
+	srv.logger.Infof("http api started at `%v`", srv.httpServer.Addr)
+	return nil
+}
+
+func (srv *server) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	err := srv.httpServer.Shutdown(ctx)
+	srv.realmList.Close()
+	return err
+}
+
+func (srv *server) allow(method string, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			writeError(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+			return
+		}
+		h(w, r)
+	}
+}
+
+// adminOnly requires `Authorization: Bearer <token>` header matching configured admin token.
+func (srv *server) adminOnly(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := srv.config.HTTPAPI.AdminToken
+		if token == "" {
+			writeError(w, http.StatusForbidden, errAdminDisabled)
+			return
+		}
+
+		got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) == 0 {
+			srv.logger.Warnf("http api rejected admin request from %v", r.RemoteAddr)
+			writeError(w, http.StatusUnauthorized, errUnauthorized)
+			return
+		}
+		h(w, r)
+	}
+}
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"xcore/auth"
+	"xcore/config"
+	"xcore/core/logger"
+	"xcore/core/models"
+)
+
+const testAdminToken = "secret"
+
+type fakeAccountRepository struct {
+	auth.AccountRepository
+	accounts  map[string]*models.Account
+	passwords map[string]string
+	totpSteps map[uint]uint64
+}
+
+func (r *fakeAccountRepository) CreateAccount(name string, password string) error {
+	if name == "" || strings.ContainsAny(name, " -") {
+		return auth.ErrInvalidAccountName
+	}
+	name = strings.ToUpper(name)
+	if r.accounts[name] != nil {
+		return auth.ErrAccountExists
+	}
+	r.accounts[name] = &models.Account{Name: name}
+	r.accounts[name].ID = uint(len(r.accounts))
+	r.passwords[name] = password
+	return nil
+}
+
+func (r *fakeAccountRepository) GetAccountWithName(name string) (*models.Account, error) {
+	return r.accounts[strings.ToUpper(name)], nil
+}
+
+func (r *fakeAccountRepository) VerifyAccountPassword(name string, password string) (*models.Account, error) {
+	name = strings.ToUpper(name)
+	if acc := r.accounts[name]; acc != nil && r.passwords[name] == password {
+		return acc, nil
+	}
+	return nil, nil
+}
+
+func (r *fakeAccountRepository) SetAccountPassword(name string, password string) error {
+	r.passwords[strings.ToUpper(name)] = password
+	return nil
+}
+
+func (r *fakeAccountRepository) AcceptTotpStep(accountID uint, step uint64) (bool, error) {
+	if r.totpSteps[accountID] >= step {
+		return false, nil
+	}
+	r.totpSteps[accountID] = step
+	return true, nil
+}
+
+type fakeBanRepository struct {
+	auth.BanRepository
+	banned map[uint]time.Duration
+}
+
+func (r *fakeBanRepository) BanAccount(accountID uint, reason string, author string, duration time.Duration) error {
+	r.banned[accountID] = duration
+	return nil
+}
+
+func (r *fakeBanRepository) UnbanAccount(accountID uint) error {
+	delete(r.banned, accountID)
+	return nil
+}
+
+type fakeOnlineAccountRepository struct {
+	auth.OnlineAccountRepository
+	kicked []uint
+}
+
+func (r *fakeOnlineAccountRepository) RequestKick(accountID uint) error {
+	r.kicked = append(r.kicked, accountID)
+	return nil
+}
+
+type fakeRealmProvider struct {
+	auth.RealmProvider
+	realms []*models.Realm
+}
+
+func (p *fakeRealmProvider) GetRealms() []*models.Realm {
+	return p.realms
+}
+
+type fakeRealmStatusRepository struct {
+	auth.RealmStatusRepository
+	statuses []*models.RealmStatus
+}
+
+func (r *fakeRealmStatusRepository) GetRealmStatuses() ([]*models.RealmStatus, error) {
+	return r.statuses, nil
+}
+
+func newTestServer(t *testing.T) *server {
+	c := config.Default()
+	c.HTTPAPI.AdminToken = testAdminToken
+	c.Lockout.MaxFailedAttempts = 3
+
+	l, err := logger.NewWithWriter(&config.LogConfig{Level: "error"}, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &server{
+		config: c,
+		logger: l,
+		accRepo: &fakeAccountRepository{
+			accounts:  map[string]*models.Account{},
+			passwords: map[string]string{},
+			totpSteps: map[uint]uint64{},
+		},
+		banRepo:    &fakeBanRepository{banned: map[uint]time.Duration{}},
+		realmList:  &fakeRealmProvider{},
+		statusRepo: &fakeRealmStatusRepository{},
+		onlineRepo: &fakeOnlineAccountRepository{},
+		limiter:    auth.NewLoginLimiter(c.Lockout),
+	}
+}
+
+// do sends request with JSON body to server, admin token is added to admin requests.
+func (srv *server) do(t *testing.T, method string, path string, body interface{}) *httptest.ResponseRecorder {
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := httptest.NewRequest(method, path, &buf)
+	if strings.HasPrefix(path, "/api/admin/") {
+		r.Header.Set("Authorization", "Bearer "+testAdminToken)
+	}
+	w := httptest.NewRecorder()
+	srv.handler().ServeHTTP(w, r)
+	return w
+}
+
+func (srv *server) mustCreateAccount(t *testing.T, name string, password string) *models.Account {
+	if err := srv.accRepo.CreateAccount(name, password); err != nil {
+		t.Fatal(err)
+	}
+	acc, _ := srv.accRepo.GetAccountWithName(name)
+	return acc
+}
+
+func assertStatus(t *testing.T, w *httptest.ResponseRecorder, want int) {
+	t.Helper()
+	if w.Code != want {
+		t.Fatalf("got status %v with body %v, want %v", w.Code, w.Body, want)
+	}
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	srv := newTestServer(t)
+	srv.mustCreateAccount(t, "player", "pass")
+	assertStatus(t, srv.do(t, http.MethodGet, "/api/accounts", nil), http.StatusMethodNotAllowed)
+	assertStatus(t, srv.do(t, http.MethodPost, "/api/realms", nil), http.StatusMethodNotAllowed)
+	assertStatus(t, srv.do(t, http.MethodPut, "/api/admin/bans/account", banRequest{Account: "player"}), http.StatusMethodNotAllowed)
+}
+
+func TestAdminOnly(t *testing.T) {
+	srv := newTestServer(t)
+	srv.mustCreateAccount(t, "player", "pass")
+
+	r := httptest.NewRequest(http.MethodPost, "/api/admin/bans/account", strings.NewReader(`{"account":"player"}`))
+	r.Header.Set("Authorization", "Bearer wrong")
+	w := httptest.NewRecorder()
+	srv.handler().ServeHTTP(w, r)
+	assertStatus(t, w, http.StatusUnauthorized)
+
+	srv.config.HTTPAPI.AdminToken = ""
+	assertStatus(t, srv.do(t, http.MethodPost, "/api/admin/bans/account", banRequest{Account: "player"}), http.StatusForbidden)
+}
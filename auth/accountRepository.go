@@ -2,7 +2,10 @@ package auth
 
 import (
 	"crypto/sha1"
+	"crypto/subtle"
+	"database/sql"
 	"encoding/hex"
+	"errors"
 	"github.com/jinzhu/gorm"
 	"strings"
 	"xcore/config"
@@ -10,19 +13,45 @@ import (
 	"xcore/core/models"
 )
 
+// maxAccountNameLength is size of name column.
+const maxAccountNameLength = 16
+
+var (
+	ErrAccountNotFound    = errors.New("account not found")
+	ErrAccountExists      = errors.New("account already exists")
+	ErrInvalidAccountName = errors.New("account name must be 1-16 latin letters or digits")
+)
+
 type AccountRepository interface {
+	// CreateAccount returns ErrInvalidAccountName unless name is 1-16 latin letters or digits.
 	CreateAccount(name string, password string) error
+	// CreateDevAccounts creates missing accounts, existing ones are left untouched.
+	CreateDevAccounts(accounts []*config.DevAccount) error
+	// DeleteAccount deletes account with its bans, online mark and characters.
+	DeleteAccount(name string) error
 	GetAccountWithName(name string) (*models.Account, error)
+	GetAccounts() ([]*models.Account, error)
 	SaveAccount(a *models.Account) error
+	SetAccountPassword(name string, password string) error
+	SetAccountGMLevel(name string, level uint8) error
+	// AcceptTotpStep records time step of accepted authenticator code,
+	// false is returned if code of the same or later step was accepted already.
+	AcceptTotpStep(accountID uint, step uint64) (bool, error)
+	// VerifyAccountPassword returns account if password matches and nil otherwise.
+	VerifyAccountPassword(name string, password string) (*models.Account, error)
 }
 
 type accountRepository struct {
 	db *db.DB
+	// keys is nil unless auth and world servers run in the same process
+	keys SessionKeyStore
 }
 
-func NewAccountRepository(c *config.Config, db *db.DB) (AccountRepository, error) {
+// NewAccountRepository creates repository, changed accounts are evicted from keys if it is not nil.
+func NewAccountRepository(c *config.Config, db *db.DB, keys SessionKeyStore) (AccountRepository, error) {
 	r := &accountRepository{
-		db: db,
+		db:   db,
+		keys: keys,
 	}
 	if err := r.init(c); err != nil {
 		return nil, err
@@ -34,7 +63,7 @@ func (r *accountRepository) init(c *config.Config) error {
 	if err := r.migrate(); err != nil {
 		return err
 	}
-	if err := r.createDevAccounts(c.DevAccounts); err != nil {
+	if err := r.CreateDevAccounts(c.DevAccounts); err != nil {
 		return err
 	}
 	return nil
@@ -44,12 +73,12 @@ func (r *accountRepository) migrate() error {
 	return r.db.AutoMigrate(&models.Account{}).Error
 }
 
-func (r *accountRepository) createDevAccounts(accounts []*config.DevAccount) error {
+func (r *accountRepository) CreateDevAccounts(accounts []*config.DevAccount) error {
 	for _, a := range accounts {
 		nameUpper := strings.ToUpper(a.Name)
 		exists, err := r.HasAccountWithName(nameUpper)
 		if err != nil {
-			return nil
+			return err
 		}
 
 		if exists {
@@ -64,22 +93,130 @@ func (r *accountRepository) createDevAccounts(accounts []*config.DevAccount) err
 }
 
 func (r *accountRepository) CreateAccount(name string, password string) error {
+	if !isValidAccountName(name) {
+		return ErrInvalidAccountName
+	}
+
 	nameUpper := strings.ToUpper(name)
-	passUpper := strings.ToUpper(password)
+	exists, err := r.HasAccountWithName(nameUpper)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return ErrAccountExists
+	}
+
+	acc := models.Account{Name: nameUpper, PasswordHash: passwordHash(nameUpper, password)}
+	return r.db.Save(&acc).Error
+}
+
+func (r *accountRepository) DeleteAccount(name string) error {
+	acc, err := r.mustGetAccountWithName(name)
+	if err != nil {
+		return err
+	}
+
+	err = r.db.Transaction(func(tx *db.DB) error {
+		dependents := []interface{}{
+			&models.AccountBan{},
+			&models.OnlineAccount{},
+			&models.Character{},
+			&models.RealmCharacters{},
+		}
+		for _, d := range dependents {
+			// tables of world server are missing until it runs once
+			if !tx.HasTable(d) {
+				continue
+			}
+			if err := tx.Unscoped().Where("account_id = ?", acc.ID).Delete(d).Error; err != nil {
+				return err
+			}
+		}
+
+		res := tx.Unscoped().Delete(acc)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrAccountNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.evict(acc.ID)
+	return nil
+}
+
+func (r *accountRepository) SetAccountPassword(name string, password string) error {
+	acc, err := r.mustGetAccountWithName(name)
+	if err != nil {
+		return err
+	}
+
+	acc.PasswordHash = passwordHash(acc.Name, password)
+	// verifier and session key are derived from the old password
+	acc.PasswordKey = sql.NullString{}
+	acc.SessionKey = sql.NullString{}
+	return r.SaveAccount(acc)
+}
+
+func (r *accountRepository) SetAccountGMLevel(name string, level uint8) error {
+	acc, err := r.mustGetAccountWithName(name)
+	if err != nil {
+		return err
+	}
+
+	acc.GMLevel = level
+	return r.SaveAccount(acc)
+}
+
+func (r *accountRepository) AcceptTotpStep(accountID uint, step uint64) (bool, error) {
+	// conditional update lets only one of concurrent logins with the same code in
+	res := r.db.Model(&models.Account{}).
+		Where("id = ? AND totp_last_step < ?", accountID, step).
+		UpdateColumn("totp_last_step", step)
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *accountRepository) VerifyAccountPassword(name string, password string) (*models.Account, error) {
+	acc, err := r.GetAccountWithName(name)
+	if err != nil || acc == nil {
+		return nil, err
+	}
+
+	h := passwordHash(acc.Name, password)
+	if subtle.ConstantTimeCompare([]byte(strings.ToLower(acc.PasswordHash)), []byte(h)) == 0 {
+		return nil, nil
+	}
+	return acc, nil
+}
+
+func isValidAccountName(name string) bool {
+	if len(name) == 0 || len(name) > maxAccountNameLength {
+		return false
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
 
+func passwordHash(name string, password string) string {
 	passHash := sha1.New()
-	passHash.Write([]byte(nameUpper))
+	passHash.Write([]byte(strings.ToUpper(name)))
 	passHash.Write([]byte(":"))
-	passHash.Write([]byte(passUpper))
-	hashHex := hex.EncodeToString(passHash.Sum(nil))
-	acc := models.Account{Name: nameUpper, PasswordHash: hashHex}
-	return r.db.Save(&acc).Error
+	passHash.Write([]byte(strings.ToUpper(password)))
+	return hex.EncodeToString(passHash.Sum(nil))
 }
 
 func (r *accountRepository) HasAccountWithName(name string) (bool, error) {
 	var count int
-	r.db.Where("name = ?", name).Find(&models.Account{}).Count(&count)
-	if err := r.db.Error; err != nil {
+	if err := r.db.Model(&models.Account{}).Where("name = ?", strings.ToUpper(name)).Count(&count).Error; err != nil {
 		return false, err
 	}
 	return count > 0, nil
@@ -94,6 +231,33 @@ func (r *accountRepository) GetAccountWithName(name string) (*models.Account, er
 	return &acc, err
 }
 
+func (r *accountRepository) mustGetAccountWithName(name string) (*models.Account, error) {
+	acc, err := r.GetAccountWithName(name)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil {
+		return nil, ErrAccountNotFound
+	}
+	return acc, nil
+}
+
+func (r *accountRepository) GetAccounts() ([]*models.Account, error) {
+	var accounts []*models.Account
+	err := r.db.Order("name").Find(&accounts).Error
+	return accounts, err
+}
+
 func (r *accountRepository) SaveAccount(a *models.Account) error {
-	return r.db.Save(*a).Error
+	if err := r.db.Save(a).Error; err != nil {
+		return err
+	}
+	r.evict(a.ID)
+	return nil
+}
+
+func (r *accountRepository) evict(accountID uint) {
+	if r.keys != nil {
+		r.keys.Evict(accountID)
+	}
 }
@@ -0,0 +1,23 @@
+package auth
+
+import "testing"
+
+func TestIsValidAccountName(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"Player1", true},
+		{"ABCDEFGHIJKLMNOP", true},
+		{"ABCDEFGHIJKLMNOPQ", false},
+		{"", false},
+		{"with space", false},
+		{"dash-name", false},
+		{"Игрок", false},
+	}
+	for _, tt := range tests {
+		if got := isValidAccountName(tt.name); got != tt.want {
+			t.Errorf("isValidAccountName(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
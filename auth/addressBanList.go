@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"net"
+	"sync"
+	"time"
+	"xcore/core/logger"
+	"xcore/core/models"
+)
+
+type addressBan struct {
+	network *net.IPNet
+	ban     *models.IPBan
+}
+
+// addressBanList keeps active address bans in memory, so accepted connections
+// are checked without database query. If refresh fails the last loaded list stays in use.
+type addressBanList struct {
+	repo   BanRepository
+	logger logger.Logger
+
+	mu   sync.RWMutex
+	bans []addressBan
+
+	stop chan struct{}
+}
+
+func newAddressBanList(repo BanRepository, interval time.Duration, l logger.Logger) (*addressBanList, error) {
+	b := &addressBanList{
+		repo:   repo,
+		logger: l,
+		stop:   make(chan struct{}),
+	}
+	if err := b.refresh(); err != nil {
+		return nil, err
+	}
+
+	go b.refreshLoop(interval)
+	return b, nil
+}
+
+// find returns unexpired ban matching ip or nil.
+func (b *addressBanList) find(ip net.IP) *models.IPBan {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	now := time.Now()
+	for _, ab := range b.bans {
+		if ab.ban.ExpiresAt != nil && !ab.ban.ExpiresAt.After(now) {
+			continue
+		}
+		if ab.network.Contains(ip) {
+			return ab.ban
+		}
+	}
+	return nil
+}
+
+func (b *addressBanList) refresh() error {
+	loaded, err := b.repo.GetActiveAddressBans()
+	if err != nil {
+		return err
+	}
+
+	bans := make([]addressBan, 0, len(loaded))
+	for _, ban := range loaded {
+		_, network, err := net.ParseCIDR(ban.Address)
+		if err != nil {
+			b.logger.Warnf("skipping address ban `%v`: %v", ban.Address, err)
+			continue
+		}
+		bans = append(bans, addressBan{network: network, ban: ban})
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.bans = bans
+	return nil
+}
+
+func (b *addressBanList) refreshLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := b.refresh(); err != nil {
+				b.logger.Errorf("can not refresh address bans, keeping previous list: %v", err)
+			}
+		case <-b.stop:
+			return
+		}
+	}
+}
+
+func (b *addressBanList) close() {
+	close(b.stop)
+}
@@ -0,0 +1,161 @@
+package auth
+
+import (
+	"errors"
+	"github.com/jinzhu/gorm"
+	"net"
+	"time"
+	"xcore/core/db"
+	"xcore/core/models"
+)
+
+var (
+	ErrInvalidBanAddress = errors.New("invalid ban address")
+)
+
+type BanRepository interface {
+	// BanAccount bans account for duration, zero duration means permanent ban.
+	// Session key of account is cleared, so it has to pass ban check at logon again.
+	BanAccount(accountID uint, reason string, author string, duration time.Duration) error
+	UnbanAccount(accountID uint) error
+	// GetActiveAccountBan returns active ban of account or nil. Expired bans are lifted.
+	GetActiveAccountBan(accountID uint) (*models.AccountBan, error)
+
+	// BanAddress bans single IP address or CIDR range for duration, zero duration means permanent ban.
+	BanAddress(address string, reason string, author string, duration time.Duration) error
+	UnbanAddress(address string) error
+	// GetActiveAddressBans returns all unexpired address bans.
+	GetActiveAddressBans() ([]*models.IPBan, error)
+}
+
+type banRepository struct {
+	db *db.DB
+	// keys is nil unless auth and world servers run in the same process
+	keys SessionKeyStore
+}
+
+// NewBanRepository creates repository, banned accounts are evicted from keys if it is not nil.
+func NewBanRepository(db *db.DB, keys SessionKeyStore) (BanRepository, error) {
+	r := &banRepository{
+		db:   db,
+		keys: keys,
+	}
+	if err := r.migrate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *banRepository) migrate() error {
+	return r.db.AutoMigrate(&models.AccountBan{}, &models.IPBan{}).Error
+}
+
+func (r *banRepository) BanAccount(accountID uint, reason string, author string, duration time.Duration) error {
+	now := time.Now()
+	ban := models.AccountBan{
+		AccountID: accountID,
+		Reason:    reason,
+		Author:    author,
+		BannedAt:  now,
+		Active:    true,
+	}
+	if duration > 0 {
+		expiresAt := now.Add(duration)
+		ban.ExpiresAt = &expiresAt
+	}
+
+	err := r.db.Transaction(func(tx *db.DB) error {
+		if err := tx.Save(&ban).Error; err != nil {
+			return err
+		}
+		// saved session key would let banned account in with reconnect
+		return tx.Model(&models.Account{}).
+			Where("id = ?", accountID).
+			Update("session_key", gorm.Expr("NULL")).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	if r.keys != nil {
+		r.keys.Evict(accountID)
+	}
+	return nil
+}
+
+func (r *banRepository) UnbanAccount(accountID uint) error {
+	return r.db.Model(&models.AccountBan{}).
+		Where("account_id = ? AND active = ?", accountID, true).
+		Update("active", false).Error
+}
+
+func (r *banRepository) GetActiveAccountBan(accountID uint) (*models.AccountBan, error) {
+	if err := r.liftExpiredAccountBans(accountID); err != nil {
+		return nil, err
+	}
+
+	var ban models.AccountBan
+	err := r.db.Where("account_id = ? AND active = ?", accountID, true).
+		Order("expires_at IS NOT NULL, expires_at DESC").
+		First(&ban).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &ban, err
+}
+
+func (r *banRepository) liftExpiredAccountBans(accountID uint) error {
+	return r.db.Model(&models.AccountBan{}).
+		Where("account_id = ? AND active = ? AND expires_at <= ?", accountID, true, time.Now()).
+		Update("active", false).Error
+}
+
+func (r *banRepository) BanAddress(address string, reason string, author string, duration time.Duration) error {
+	cidr, err := normalizeBanAddress(address)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	ban := models.IPBan{
+		Address:  cidr,
+		Reason:   reason,
+		Author:   author,
+		BannedAt: now,
+	}
+	if duration > 0 {
+		expiresAt := now.Add(duration)
+		ban.ExpiresAt = &expiresAt
+	}
+	return r.db.Save(&ban).Error
+}
+
+func (r *banRepository) UnbanAddress(address string) error {
+	cidr, err := normalizeBanAddress(address)
+	if err != nil {
+		return err
+	}
+	return r.db.Where("address = ?", cidr).Delete(&models.IPBan{}).Error
+}
+
+func (r *banRepository) GetActiveAddressBans() ([]*models.IPBan, error) {
+	var bans []*models.IPBan
+	err := r.db.Where("expires_at IS NULL OR expires_at > ?", time.Now()).Find(&bans).Error
+	return bans, err
+}
+
+// normalizeBanAddress converts single address to CIDR notation.
+func normalizeBanAddress(address string) (string, error) {
+	if _, n, err := net.ParseCIDR(address); err == nil {
+		return n.String(), nil
+	}
+
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return "", ErrInvalidBanAddress
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return (&net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}).String(), nil
+	}
+	return (&net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}).String(), nil
+}
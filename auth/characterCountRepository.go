@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"github.com/jinzhu/gorm"
+	"xcore/core/db"
+	"xcore/core/models"
+)
+
+type CharacterCountRepository interface {
+	// GetCharacterCounts returns characters count of account by realm ID.
+	GetCharacterCounts(accountID uint) (map[uint8]uint8, error)
+	// AddCharacterCount changes characters count of account on realm by delta.
+	AddCharacterCount(accountID uint, realmID uint8, delta int) error
+}
+
+type characterCountRepository struct {
+	db *db.DB
+}
+
+func NewCharacterCountRepository(db *db.DB) (CharacterCountRepository, error) {
+	r := &characterCountRepository{
+		db: db,
+	}
+	if err := r.migrate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *characterCountRepository) migrate() error {
+	return r.db.AutoMigrate(&models.RealmCharacters{}).Error
+}
+
+func (r *characterCountRepository) GetCharacterCounts(accountID uint) (map[uint8]uint8, error) {
+	var rows []*models.RealmCharacters
+	if err := r.db.Where("account_id = ?", accountID).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uint8]uint8, len(rows))
+	for _, row := range rows {
+		counts[row.RealmID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *characterCountRepository) AddCharacterCount(accountID uint, realmID uint8, delta int) error {
+	return AddCharacterCount(r.db, accountID, realmID, delta)
+}
+
+// AddCharacterCount changes characters count of account on realm by delta, count never goes below zero.
+// It is called with transaction which creates or deletes the character, so count can not drift from characters.
+// Only one world session of account creates or deletes its characters at a time, so row creation does not race.
+func AddCharacterCount(db *db.DB, accountID uint, realmID uint8, delta int) error {
+	row := models.RealmCharacters{AccountID: accountID, RealmID: realmID}
+	if err := db.FirstOrCreate(&row, row).Error; err != nil {
+		return err
+	}
+
+	return db.Model(&row).
+		Update("count", gorm.Expr("GREATEST(count + ?, 0)", delta)).Error
+}
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"strings"
+	"sync"
+	"time"
+	"xcore/config"
+)
+
+type failedLogins struct {
+	count       int
+	windowStart time.Time
+	lockedUntil time.Time
+}
+
+// LoginLimiter counts failed logins per account and per address
+// and locks them out after too many failures inside a time window.
+type LoginLimiter struct {
+	mu          sync.Mutex
+	config      *config.LockoutConfig
+	accounts    map[string]*failedLogins
+	addresses   map[string]*failedLogins
+	lastCleanup time.Time
+}
+
+func NewLoginLimiter(c *config.LockoutConfig) *LoginLimiter {
+	return &LoginLimiter{
+		config:    c,
+		accounts:  map[string]*failedLogins{},
+		addresses: map[string]*failedLogins{},
+	}
+}
+
+// SetConfig applies new thresholds, existing counters are kept.
+func (l *LoginLimiter) SetConfig(c *config.LockoutConfig) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.config = c
+}
+
+// enabled has to be called with mu locked.
+func (l *LoginLimiter) enabled() bool {
+	return l.config != nil && l.config.MaxFailedAttempts > 0
+}
+
+// IsLocked reports whether account or address is locked out.
+func (l *LoginLimiter) IsLocked(accName string, address string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled() {
+		return false
+	}
+
+	now := time.Now()
+	if f := l.accounts[strings.ToUpper(accName)]; f != nil && now.Before(f.lockedUntil) {
+		return true
+	}
+	if f := l.addresses[address]; f != nil && now.Before(f.lockedUntil) {
+		return true
+	}
+	return false
+}
+
+// RegisterFailure counts failed login and returns true if account or address became locked.
+func (l *LoginLimiter) RegisterFailure(accName string, address string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled() {
+		return false
+	}
+
+	now := time.Now()
+	l.cleanup(now)
+
+	accLocked := l.count(l.accounts, strings.ToUpper(accName), now)
+	addrLocked := l.count(l.addresses, address, now)
+	return accLocked || addrLocked
+}
+
+// RegisterAttempt counts attempt of address which is throttled regardless of its result, e.g. registration,
+// and returns true if address became locked.
+func (l *LoginLimiter) RegisterAttempt(address string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled() {
+		return false
+	}
+
+	now := time.Now()
+	l.cleanup(now)
+	return l.count(l.addresses, address, now)
+}
+
+// Reset clears failed logins of account after successful login.
+func (l *LoginLimiter) Reset(accName string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.accounts, strings.ToUpper(accName))
+}
+
+func (l *LoginLimiter) count(m map[string]*failedLogins, key string, now time.Time) bool {
+	f := m[key]
+	if f == nil || now.Sub(f.windowStart) > l.config.Window {
+		f = &failedLogins{windowStart: now}
+		m[key] = f
+	}
+
+	f.count++
+	if f.count >= l.config.MaxFailedAttempts {
+		f.lockedUntil = now.Add(l.config.Duration)
+		return true
+	}
+	return false
+}
+
+// cleanup removes expired counters at most once per window.
+func (l *LoginLimiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < l.config.Window {
+		return
+	}
+	l.lastCleanup = now
+
+	for _, m := range []map[string]*failedLogins{l.accounts, l.addresses} {
+		for k, f := range m {
+			if now.Sub(f.windowStart) > l.config.Window && now.After(f.lockedUntil) {
+				delete(m, k)
+			}
+		}
+	}
+}
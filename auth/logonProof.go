@@ -24,7 +24,8 @@ type logonProof struct {
 	xM1           [20]uint8
 	crcHash       [20]uint8
 	keysCount     uint8
-	securityFlags uint8
+	securityFlags securityFlags
+	token         string
 }
 
 func newLogonProof(b []byte) (*logonProof, error) {
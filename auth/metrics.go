@@ -0,0 +1,33 @@
+package auth
+
+import "xcore/core/metrics"
+
+var (
+	connectionsAccepted = metrics.NewCounterVec(
+		"xcore_auth_connections_accepted_total",
+		"Connections accepted by auth server.",
+	)
+	sessionsActive = metrics.NewGaugeVec(
+		"xcore_auth_sessions_active",
+		"Auth sessions currently in progress.",
+	)
+	logonAttempts = metrics.NewCounterVec(
+		"xcore_auth_logon_attempts_total",
+		"Logon and reconnect attempts by result sent to client.",
+		"result",
+	)
+	srpProofFailures = metrics.NewCounterVec(
+		"xcore_auth_srp_proof_failures_total",
+		"Logon proofs with invalid SRP client proof.",
+	)
+	realmListRequests = metrics.NewCounterVec(
+		"xcore_auth_realm_list_requests_total",
+		"Realm list requests.",
+	)
+	handlerDuration = metrics.NewHistogramVec(
+		"xcore_auth_handler_duration_seconds",
+		"Duration of auth opcode handlers.",
+		metrics.DefaultLatencyBuckets,
+		"opcode",
+	)
+)
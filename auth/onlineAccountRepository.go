@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"github.com/jinzhu/gorm"
+	"time"
+	"xcore/core/db"
+	"xcore/core/models"
+)
+
+type OnlineAccountRepository interface {
+	SetOnline(accountID uint, realmID uint8, sessionID string) error
+	// SetOffline removes online mark of account if it belongs to the session.
+	SetOffline(accountID uint, sessionID string) error
+	// ClearRealm removes all online marks of realm, e.g. left after world server crash.
+	ClearRealm(realmID uint8) error
+	// GetOnlineAccount returns online mark of account or nil if account is offline.
+	GetOnlineAccount(accountID uint) (*models.OnlineAccount, error)
+	GetOnlineAccounts(realmID uint8) ([]*models.OnlineAccount, error)
+	// RequestKick asks world server to close session of account. World server of the same process
+	// is woken up at once, standalone one picks request up on its next heartbeat.
+	RequestKick(accountID uint) error
+	GetKickRequests(realmID uint8) ([]*models.OnlineAccount, error)
+}
+
+type onlineAccountRepository struct {
+	db *db.DB
+	// kicks is nil unless world server runs in the same process
+	kicks chan<- struct{}
+}
+
+// NewOnlineAccountRepository creates repository, kick requests are signaled to kicks if it is not nil.
+func NewOnlineAccountRepository(db *db.DB, kicks chan<- struct{}) (OnlineAccountRepository, error) {
+	r := &onlineAccountRepository{
+		db:    db,
+		kicks: kicks,
+	}
+	if err := r.migrate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *onlineAccountRepository) migrate() error {
+	return r.db.AutoMigrate(&models.OnlineAccount{}).Error
+}
+
+func (r *onlineAccountRepository) SetOnline(accountID uint, realmID uint8, sessionID string) error {
+	return r.db.Save(&models.OnlineAccount{
+		AccountID: accountID,
+		RealmID:   realmID,
+		SessionID: sessionID,
+		Since:     time.Now(),
+	}).Error
+}
+
+func (r *onlineAccountRepository) SetOffline(accountID uint, sessionID string) error {
+	return r.db.Where("account_id = ? AND session_id = ?", accountID, sessionID).
+		Delete(&models.OnlineAccount{}).Error
+}
+
+func (r *onlineAccountRepository) ClearRealm(realmID uint8) error {
+	return r.db.Where("realm_id = ?", realmID).Delete(&models.OnlineAccount{}).Error
+}
+
+func (r *onlineAccountRepository) GetOnlineAccount(accountID uint) (*models.OnlineAccount, error) {
+	var acc models.OnlineAccount
+	err := r.db.Where("account_id = ?", accountID).First(&acc).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &acc, err
+}
+
+func (r *onlineAccountRepository) GetOnlineAccounts(realmID uint8) ([]*models.OnlineAccount, error) {
+	var accounts []*models.OnlineAccount
+	err := r.db.Where("realm_id = ?", realmID).Find(&accounts).Error
+	return accounts, err
+}
+
+func (r *onlineAccountRepository) RequestKick(accountID uint) error {
+	err := r.db.Model(&models.OnlineAccount{}).
+		Where("account_id = ?", accountID).
+		Update("kick_requested", true).Error
+	if err != nil || r.kicks == nil {
+		return err
+	}
+
+	// pending signal already makes world server read all requests
+	select {
+	case r.kicks <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (r *onlineAccountRepository) GetKickRequests(realmID uint8) ([]*models.OnlineAccount, error) {
+	var accounts []*models.OnlineAccount
+	err := r.db.Where("realm_id = ? AND kick_requested = ?", realmID, true).Find(&accounts).Error
+	return accounts, err
+}
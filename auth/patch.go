@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+type patch struct {
+	path string
+	size int64
+	md5  []byte
+}
+
+type patchDigest struct {
+	modTime time.Time
+	md5     []byte
+}
+
+// patchDigests caches MD5 of patch files by path until file is modified.
+var patchDigests sync.Map
+
+// patchFileName returns name of patch file for client build, platform, os and locale.
+// They come from client, so false is returned unless all of them are alphanumeric.
+func patchFileName(c *logonChallenge) (string, bool) {
+	parts := []string{c.platform, c.os, c.country}
+	for i, part := range parts {
+		parts[i] = strings.Trim(part, "\x00")
+		if !isAlphanumeric(parts[i]) {
+			return "", false
+		}
+	}
+	return fmt.Sprintf("%v-%v-%v-%v.mpq", c.build, parts[0], parts[1], parts[2]), true
+}
+
+func isAlphanumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// findPatch returns patch for client build, platform and locale or nil if there is no such patch
+// or client sent platform, os or locale which can not be part of file name.
+func findPatch(dir string, c *logonChallenge) (*patch, error) {
+	if dir == "" {
+		return nil, nil
+	}
+
+	name, ok := patchFileName(c)
+	if !ok {
+		return nil, nil
+	}
+
+	path := filepath.Join(dir, name)
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if d, ok := patchDigests.Load(path); ok && d.(*patchDigest).modTime.Equal(info.ModTime()) {
+		return &patch{path: path, size: info.Size(), md5: d.(*patchDigest).md5}, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+
+	d := &patchDigest{modTime: info.ModTime(), md5: h.Sum(nil)}
+	patchDigests.Store(path, d)
+
+	return &patch{path: path, size: info.Size(), md5: d.md5}, nil
+}
@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"io"
+	"os"
+	"time"
+)
+
+const (
+	xferResumeMsgSize = 8
+	xferChunkSize     = 4096
+	xferReadTimeout   = time.Minute * 30
+)
+
+var xferFileName = []byte("Patch")
+
+func (s *session) initiatePatchTransfer(payload *logonChallenge) error {
+	p, err := findPatch(s.srv.config.PatchDir, payload)
+	if err != nil {
+		return err
+	}
+
+	if p == nil {
+		s.logger.Infof("no patch for build %v %q %q %q", payload.build, payload.platform, payload.os, payload.country)
+		return s.closeWithResult(resultVersionInvalid, logonChallengeOpcode)
+	}
+
+	s.patch = p
+	s.xferCancel = make(chan struct{})
+	logonAttempts.Inc(resultVersionUpdate.String())
+
+	s.sock.BeginWrite().
+		MustWriteByte(byte(logonChallengeOpcode)).
+		MustWriteByte(0x00).
+		MustWriteByte(byte(resultVersionUpdate))
+
+	s.sock.MustWriteByte(byte(xferInitiateOpcode)).
+		MustWriteByte(byte(len(xferFileName))).
+		MustWriteBytes(xferFileName).
+		MustWriteUInt64(uint64(p.size)).
+		MustWriteBytes(p.md5)
+
+	if err := s.sock.CommitWrite(); err != nil {
+		return err
+	}
+
+	s.logger.Infof("initiated transfer of %v", p.path)
+
+	// client may stay idle while it downloads the patch
+	s.sock.SetReadTimeout(xferReadTimeout)
+	s.setStatus(patchTransferStatus)
+	return s.continueAuth()
+}
+
+func (s *session) handleXferAcceptOpcode() error {
+	s.startPatchStream(0)
+	return s.continueAuth()
+}
+
+func (s *session) handleXferResumeOpcode() error {
+	var offset uint64
+	if err := s.sock.ReadTo(&offset); err != nil {
+		return err
+	}
+
+	if offset > uint64(s.patch.size) {
+		offset = uint64(s.patch.size)
+	}
+
+	s.startPatchStream(int64(offset))
+	return s.continueAuth()
+}
+
+// startPatchStream sends patch in background, so the session can still receive cancel.
+// Repeated accept or resume is rejected by streaming status, there is only one writer of patch data.
+func (s *session) startPatchStream(offset int64) {
+	s.setStatus(patchStreamingStatus)
+	go s.streamPatch(offset)
+}
+
+func (s *session) handleXferCancelOpcode() error {
+	s.logger.Infof("patch transfer cancelled")
+	close(s.xferCancel)
+	s.setStatus(closedStatus)
+	return s.sock.Close()
+}
+
+func (s *session) streamPatch(offset int64) {
+	err := s.sendPatchData(offset)
+	if err != nil {
+		s.logger.Warnf("patch transfer failed: %v", err)
+	} else {
+		s.logger.Infof("patch transfer completed")
+	}
+
+	if err := s.sock.Close(); err != nil {
+		s.logger.Warnf("can not close auth session: %v", err)
+	}
+}
+
+func (s *session) sendPatchData(offset int64) error {
+	f, err := os.Open(s.patch.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	chunk := make([]byte, xferChunkSize)
+	for {
+		select {
+		case <-s.xferCancel:
+			return nil
+		default:
+		}
+
+		n, err := f.Read(chunk)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		s.sock.BeginWrite().
+			MustWriteByte(byte(xferDataOpcode)).
+			MustWriteUInt16(uint16(n)).
+			MustWriteBytes(chunk[:n])
+
+		if err := s.sock.CommitWrite(); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	goNet "net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+	"xcore/config"
+	"xcore/core/logger"
+)
+
+// xferTest is auth session offering a patch over loopback connection.
+type xferTest struct {
+	t      *testing.T
+	data   []byte
+	client *goNet.TCPConn
+	s      *session
+	done   chan error
+}
+
+func newXferTest(t *testing.T, size int) *xferTest {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+
+	dir, err := ioutil.TempDir("", "xcore-patch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "8606-x86-Win-enUS.mpq")
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := goNet.ListenTCP("tcp4", &goNet.TCPAddr{IP: goNet.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	client, err := goNet.DialTCP("tcp4", nil, l.Addr().(*goNet.TCPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	conn, err := l.AcceptTCP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// small buffers keep the stream blocked while client does not read
+	client.SetReadBuffer(xferChunkSize)
+	conn.SetWriteBuffer(xferChunkSize)
+
+	lg, err := logger.NewWithWriter(&config.LogConfig{Level: "error"}, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &server{config: config.Default(), logger: lg, packetLogger: lg}
+
+	s := newSession("test", conn, srv)
+	s.patch = &patch{path: path, size: int64(size)}
+	s.xferCancel = make(chan struct{})
+	s.setStatus(patchTransferStatus)
+	t.Cleanup(func() { s.sock.Close() })
+
+	x := &xferTest{t: t, data: data, client: client, s: s, done: make(chan error, 1)}
+	go func() { x.done <- s.continueAuth() }()
+	return x
+}
+
+func (x *xferTest) send(b ...byte) {
+	if _, err := x.client.Write(b); err != nil {
+		x.t.Fatal(err)
+	}
+}
+
+// readChunk returns data of next xfer data packet.
+func (x *xferTest) readChunk() ([]byte, error) {
+	x.client.SetReadDeadline(time.Now().Add(time.Second * 5))
+	header := make([]byte, 3)
+	if _, err := io.ReadFull(x.client, header); err != nil {
+		return nil, err
+	}
+	if opcode(header[0]) != xferDataOpcode {
+		x.t.Fatalf("got opcode %v, want %v", opcode(header[0]), xferDataOpcode)
+	}
+	chunk := make([]byte, binary.LittleEndian.Uint16(header[1:]))
+	_, err := io.ReadFull(x.client, chunk)
+	return chunk, err
+}
+
+// readAll returns patch data received until server closes connection.
+func (x *xferTest) readAll() []byte {
+	var data []byte
+	for {
+		chunk, err := x.readChunk()
+		if err == io.EOF {
+			return data
+		}
+		if err != nil {
+			x.t.Fatal(err)
+		}
+		data = append(data, chunk...)
+	}
+}
+
+func (x *xferTest) wait() error {
+	select {
+	case err := <-x.done:
+		return err
+	case <-time.After(time.Second * 5):
+		x.t.Fatal("session did not finish")
+		return nil
+	}
+}
+
+func TestXferAcceptStreamsWholePatch(t *testing.T) {
+	x := newXferTest(t, xferChunkSize*2+100)
+	x.send(byte(xferAcceptOpcode))
+
+	if got := x.readAll(); !bytes.Equal(got, x.data) {
+		t.Fatalf("got %v bytes of patch, want %v", len(got), len(x.data))
+	}
+}
+
+func TestXferResumeStreamsFromOffset(t *testing.T) {
+	tests := []struct {
+		name   string
+		offset uint64
+		want   int
+	}{
+		{"middle", 5000, 5000},
+		{"beyond end", 1 << 40, xferChunkSize * 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x := newXferTest(t, xferChunkSize*2)
+			msg := make([]byte, 1+xferResumeMsgSize)
+			msg[0] = byte(xferResumeOpcode)
+			binary.LittleEndian.PutUint64(msg[1:], tt.offset)
+			x.send(msg...)
+
+			if got := x.readAll(); !bytes.Equal(got, x.data[tt.want:]) {
+				t.Fatalf("got %v bytes of patch, want %v", len(got), len(x.data)-tt.want)
+			}
+		})
+	}
+}
+
+func TestXferRepeatedAcceptIsRejected(t *testing.T) {
+	x := newXferTest(t, xferChunkSize*1024)
+	x.send(byte(xferAcceptOpcode))
+	if _, err := x.readChunk(); err != nil {
+		t.Fatal(err)
+	}
+
+	x.send(byte(xferAcceptOpcode))
+	if err := x.wait(); err != errUnexpectedOpcode {
+		t.Fatalf("got %v, want %v", err, errUnexpectedOpcode)
+	}
+	if st := x.s.getStatus(); st != closedStatus {
+		t.Fatalf("got status %v, want %v", st, closedStatus)
+	}
+}
+
+func TestXferResumeWhileStreamingIsRejected(t *testing.T) {
+	x := newXferTest(t, xferChunkSize*1024)
+	x.send(byte(xferAcceptOpcode))
+	if _, err := x.readChunk(); err != nil {
+		t.Fatal(err)
+	}
+
+	x.send(byte(xferResumeOpcode), 0, 0, 0, 0, 0, 0, 0, 0)
+	if err := x.wait(); err != errUnexpectedOpcode {
+		t.Fatalf("got %v, want %v", err, errUnexpectedOpcode)
+	}
+}
+
+func TestXferCancelWhileStreamingStopsTransfer(t *testing.T) {
+	x := newXferTest(t, xferChunkSize*1024)
+	x.send(byte(xferAcceptOpcode))
+	if _, err := x.readChunk(); err != nil {
+		t.Fatal(err)
+	}
+
+	x.send(byte(xferCancelOpcode))
+	if err := x.wait(); err != nil {
+		t.Fatalf("cancel failed: %v", err)
+	}
+	// connection is closed while the chunk may be written, so any read error ends the stream
+	var received int
+	for {
+		chunk, err := x.readChunk()
+		if err != nil {
+			break
+		}
+		received += len(chunk)
+	}
+	if received >= len(x.data) {
+		t.Fatalf("whole patch was sent after cancel")
+	}
+}
+
+func TestXferCancelBeforeAccept(t *testing.T) {
+	x := newXferTest(t, xferChunkSize)
+	x.send(byte(xferCancelOpcode))
+
+	if err := x.wait(); err != nil {
+		t.Fatalf("cancel failed: %v", err)
+	}
+	if got := x.readAll(); len(got) != 0 {
+		t.Fatalf("got %v bytes of patch after cancel", len(got))
+	}
+}
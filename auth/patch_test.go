@@ -0,0 +1,26 @@
+package auth
+
+import "testing"
+
+func TestPatchFileName(t *testing.T) {
+	tests := []struct {
+		name                  string
+		platform, os, country string
+		want                  string
+	}{
+		{"valid", "x86", "Win", "enUS", "8606-x86-Win-enUS.mpq"},
+		{"padded with zeros", "x86\x00", "OSX\x00", "deDE", "8606-x86-OSX-deDE.mpq"},
+		{"path separator", "../", "Win", "enUS", ""},
+		{"dot", "x86", "..", "enUS", ""},
+		{"backslash", "x86", "Win", `a\b`, ""},
+		{"empty", "x86", "\x00\x00\x00\x00", "enUS", ""},
+		{"non ascii", "x86", "Win", "ñ", ""},
+	}
+	for _, tt := range tests {
+		c := &logonChallenge{build: 8606, platform: tt.platform, os: tt.os, country: tt.country}
+		got, ok := patchFileName(c)
+		if ok != (tt.want != "") || got != tt.want {
+			t.Errorf("%v: got %q %v, want %q", tt.name, got, ok, tt.want)
+		}
+	}
+}
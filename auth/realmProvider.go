@@ -1,21 +1,222 @@
 package auth
 
-import "xcore/config"
+import (
+	"sync"
+	"time"
+	"xcore/config"
+	"xcore/core/db"
+	"xcore/core/logger"
+	"xcore/core/models"
+)
 
-type realmProvider struct {
-	realms []*config.RealmConfig
+type RealmProvider interface {
+	GetRealms() []*models.Realm
+	// Reload applies realm settings of c and reloads realm list.
+	Reload(c *config.Config) error
+	Close()
 }
 
-func NewRealmProvider(c *config.Config) *realmProvider {
-	return &realmProvider{
-		realms: c.Realms,
+// NewRealmProvider creates provider of realm source selected by c.
+func NewRealmProvider(c *config.Config, xdb *db.DB, l logger.Logger) (RealmProvider, error) {
+	statusRepo, err := NewRealmStatusRepository(xdb)
+	if err != nil {
+		return nil, err
 	}
+	if c.RealmSource == config.RealmSourceConfig {
+		return NewStaticRealmProvider(c, statusRepo, l)
+	}
+
+	repo, err := NewRealmRepository(c, xdb)
+	if err != nil {
+		return nil, err
+	}
+	return NewDBRealmProvider(c, repo, statusRepo, l)
+}
+
+// realmLoader loads realms without status, status is applied from heartbeats by provider.
+type realmLoader interface {
+	GetRealms() ([]*models.Realm, error)
+}
+
+// configRealms loads realms of config.
+type configRealms struct {
+	mu      sync.RWMutex
+	configs []*config.RealmConfig
+}
+
+func (r *configRealms) set(configs []*config.RealmConfig) error {
+	for _, rc := range configs {
+		if err := rc.ToModel().Prepare(); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.configs = configs
+	return nil
+}
+
+// GetRealms returns new models on every call, as provider changes flags of loaded realms.
+func (r *configRealms) GetRealms() ([]*models.Realm, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	realms := make([]*models.Realm, 0, len(r.configs))
+	for _, rc := range r.configs {
+		realms = append(realms, rc.ToModel())
+	}
+	return realms, nil
+}
+
+// staticRealmProvider lists realms of config, they are online only while world servers send heartbeats.
+type staticRealmProvider struct {
+	*dbRealmProvider
+	configs *configRealms
+}
+
+func NewStaticRealmProvider(c *config.Config, statusRepo RealmStatusRepository, l logger.Logger) (RealmProvider, error) {
+	configs := &configRealms{}
+	if err := configs.set(c.Realms); err != nil {
+		return nil, err
+	}
+
+	p, err := newDBRealmProvider(c, configs, statusRepo, l)
+	if err != nil {
+		return nil, err
+	}
+	return &staticRealmProvider{dbRealmProvider: p, configs: configs}, nil
+}
+
+// Reload replaces realm list with realms of c.
+func (p *staticRealmProvider) Reload(c *config.Config) error {
+	if err := p.configs.set(c.Realms); err != nil {
+		return err
+	}
+	return p.dbRealmProvider.Reload(c)
+}
+
+// dbRealmProvider keeps realm list loaded from database and refreshes it periodically.
+// Realms which world servers stopped sending heartbeats are marked offline.
+type dbRealmProvider struct {
+	repo             realmLoader
+	statusRepo       RealmStatusRepository
+	heartbeatTimeout time.Duration
+	logger           logger.Logger
+
+	mu     sync.RWMutex
+	realms []*models.Realm
+
+	stop chan struct{}
 }
 
-func (s *realmProvider) GetRealmsCount() int {
-	return len(s.realms)
+func NewDBRealmProvider(c *config.Config, repo RealmRepository, statusRepo RealmStatusRepository, l logger.Logger) (RealmProvider, error) {
+	return newDBRealmProvider(c, repo, statusRepo, l)
 }
 
-func (s *realmProvider) GetRealm(idx int) *config.RealmConfig {
-	return s.realms[idx]
+func newDBRealmProvider(c *config.Config, repo realmLoader, statusRepo RealmStatusRepository, l logger.Logger) (*dbRealmProvider, error) {
+	p := &dbRealmProvider{
+		logger:           l,
+		repo:             repo,
+		statusRepo:       statusRepo,
+		heartbeatTimeout: c.World.HeartbeatTimeout,
+		stop:             make(chan struct{}),
+	}
+	if err := p.refresh(); err != nil {
+		return nil, err
+	}
+
+	if c.RealmsRefreshInterval > 0 {
+		go p.refreshLoop(c.RealmsRefreshInterval)
+	}
+	return p, nil
+}
+
+func (p *dbRealmProvider) GetRealms() []*models.Realm {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.realms
+}
+
+// Reload refreshes realm list from database immediately, realms of config only seed empty table.
+func (p *dbRealmProvider) Reload(c *config.Config) error {
+	p.mu.Lock()
+	p.heartbeatTimeout = c.World.HeartbeatTimeout
+	p.mu.Unlock()
+	return p.refresh()
+}
+
+func (p *dbRealmProvider) Close() {
+	close(p.stop)
+}
+
+func (p *dbRealmProvider) refresh() error {
+	loaded, err := p.repo.GetRealms()
+	if err != nil {
+		return err
+	}
+
+	realms := make([]*models.Realm, 0, len(loaded))
+	for _, r := range loaded {
+		if err := r.Prepare(); err != nil {
+			p.logger.Warnf("skipping realm #%v \"%v\": %v", r.ID, r.Name, err)
+			continue
+		}
+		realms = append(realms, r)
+	}
+
+	statuses, err := p.statusRepo.GetRealmStatuses()
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	applyRealmStatuses(realms, statuses, p.heartbeatTimeout)
+	p.realms = realms
+	return nil
+}
+
+func (p *dbRealmProvider) refreshLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := p.refresh(); err != nil {
+				p.logger.Errorf("can not refresh realm list: %v", err)
+			}
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+// applyRealmStatuses sets offline flag and population of realms from world server heartbeats.
+func applyRealmStatuses(realms []*models.Realm, statuses []*models.RealmStatus, timeout time.Duration) {
+	byID := make(map[uint8]*models.RealmStatus, len(statuses))
+	for _, s := range statuses {
+		byID[s.RealmID] = s
+	}
+
+	now := time.Now()
+	for _, r := range realms {
+		s := byID[r.ID]
+		if s == nil || now.Sub(s.HeartbeatAt) > timeout {
+			r.Flag.Append(models.RealmFlagOffline)
+			continue
+		}
+
+		r.Flag.Remove(models.RealmFlagOffline)
+		r.Population = s.Population()
+		if s.IsFull() {
+			r.Flag.Append(models.RealmFlagFull)
+		} else {
+			r.Flag.Remove(models.RealmFlagFull)
+		}
+
+		if r.Address == "" {
+			r.Address = s.Address
+		}
+	}
 }
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+	"xcore/config"
+	"xcore/core/logger"
+	"xcore/core/models"
+)
+
+type fakeRealmStatusRepository struct {
+	RealmStatusRepository
+	statuses []*models.RealmStatus
+}
+
+func (r *fakeRealmStatusRepository) GetRealmStatuses() ([]*models.RealmStatus, error) {
+	return r.statuses, nil
+}
+
+func TestStaticRealmProviderAppliesHeartbeats(t *testing.T) {
+	c := config.Default()
+	c.RealmsRefreshInterval = 0
+	c.Realms = []*config.RealmConfig{
+		{ID: 1, Name: "Alive", Address: "127.0.0.1:8085", Version: "2.4.3.8606"},
+		{ID: 2, Name: "Stale", Version: "2.4.3.8606"},
+		{ID: 3, Name: "Never started", Version: "2.4.3.8606"},
+	}
+	statusRepo := &fakeRealmStatusRepository{statuses: []*models.RealmStatus{
+		{RealmID: 1, PlayersCount: 10, MaxPlayers: 10, HeartbeatAt: time.Now()},
+		{RealmID: 2, HeartbeatAt: time.Now().Add(-c.World.HeartbeatTimeout * 2)},
+	}}
+	l, err := logger.NewWithWriter(&config.LogConfig{Level: "error"}, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := NewStaticRealmProvider(c, statusRepo, l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	realms := p.GetRealms()
+	if len(realms) != 3 {
+		t.Fatalf("got %v realms, want 3", len(realms))
+	}
+	if r := realms[0]; r.Flag.Has(models.RealmFlagOffline) || !r.Flag.Has(models.RealmFlagFull) || r.Population != models.RealmPopulationHigh {
+		t.Errorf("realm with heartbeat: flags %v population %v", r.Flag, r.Population)
+	}
+	for _, r := range realms[1:] {
+		if !r.Flag.Has(models.RealmFlagOffline) {
+			t.Errorf("realm %v without recent heartbeat is online", r.Name)
+		}
+	}
+
+	// statuses are applied to fresh realms of config after reload
+	statusRepo.statuses = nil
+	c.Realms = c.Realms[:1]
+	if err := p.Reload(c); err != nil {
+		t.Fatal(err)
+	}
+	realms = p.GetRealms()
+	if len(realms) != 1 || !realms[0].Flag.Has(models.RealmFlagOffline) || realms[0].Flag.Has(models.RealmFlagFull) {
+		t.Errorf("got realms %+v after reload", realms)
+	}
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"xcore/config"
+	"xcore/core/db"
+	"xcore/core/models"
+)
+
+type RealmRepository interface {
+	GetRealms() ([]*models.Realm, error)
+	SaveRealm(r *models.Realm) error
+	DeleteRealm(id uint8) error
+}
+
+type realmRepository struct {
+	db *db.DB
+}
+
+func NewRealmRepository(c *config.Config, db *db.DB) (RealmRepository, error) {
+	r := &realmRepository{
+		db: db,
+	}
+	if err := r.init(c); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *realmRepository) init(c *config.Config) error {
+	if err := r.migrate(); err != nil {
+		return err
+	}
+	if err := r.seedRealms(c.Realms); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *realmRepository) migrate() error {
+	return r.db.AutoMigrate(&models.Realm{}).Error
+}
+
+// seedRealms fills empty realm table with realms from config.
+func (r *realmRepository) seedRealms(realms []*config.RealmConfig) error {
+	var count int
+	if err := r.db.Model(&models.Realm{}).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	for _, rc := range realms {
+		if err := r.SaveRealm(rc.ToModel()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *realmRepository) GetRealms() ([]*models.Realm, error) {
+	var realms []*models.Realm
+	err := r.db.Order("id").Find(&realms).Error
+	return realms, err
+}
+
+func (r *realmRepository) SaveRealm(realm *models.Realm) error {
+	return r.db.Save(realm).Error
+}
+
+func (r *realmRepository) DeleteRealm(id uint8) error {
+	return r.db.Where("id = ?", id).Delete(&models.Realm{}).Error
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"time"
+	"xcore/core/db"
+	"xcore/core/models"
+)
+
+// RealmStatusRepository links world servers with realm list of auth server.
+type RealmStatusRepository interface {
+	RegisterRealm(realmID uint8, address string, maxPlayers uint32) error
+	UnregisterRealm(realmID uint8) error
+	Heartbeat(realmID uint8, playersCount uint32) error
+	GetRealmStatuses() ([]*models.RealmStatus, error)
+}
+
+type realmStatusRepository struct {
+	db *db.DB
+}
+
+func NewRealmStatusRepository(db *db.DB) (RealmStatusRepository, error) {
+	r := &realmStatusRepository{
+		db: db,
+	}
+	if err := r.migrate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *realmStatusRepository) migrate() error {
+	return r.db.AutoMigrate(&models.RealmStatus{}).Error
+}
+
+func (r *realmStatusRepository) RegisterRealm(realmID uint8, address string, maxPlayers uint32) error {
+	now := time.Now()
+	return r.db.Save(&models.RealmStatus{
+		RealmID:     realmID,
+		Address:     address,
+		MaxPlayers:  maxPlayers,
+		StartedAt:   now,
+		HeartbeatAt: now,
+	}).Error
+}
+
+func (r *realmStatusRepository) UnregisterRealm(realmID uint8) error {
+	return r.db.Where("realm_id = ?", realmID).Delete(&models.RealmStatus{}).Error
+}
+
+func (r *realmStatusRepository) Heartbeat(realmID uint8, playersCount uint32) error {
+	return r.db.Model(&models.RealmStatus{}).
+		Where("realm_id = ?", realmID).
+		Updates(map[string]interface{}{
+			"players_count": playersCount,
+			"heartbeat_at":  time.Now(),
+		}).Error
+}
+
+func (r *realmStatusRepository) GetRealmStatuses() ([]*models.RealmStatus, error) {
+	var statuses []*models.RealmStatus
+	err := r.db.Find(&statuses).Error
+	return statuses, err
+}
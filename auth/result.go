@@ -1,5 +1,7 @@
 package auth
 
+import "fmt"
+
 type result uint8
 
 const (
@@ -30,3 +32,29 @@ const (
 	resultConversionRequired          result = 0x20
 	resultDisconnected                result = 0xFF
 )
+
+func (r result) String() string {
+	switch r {
+	case resultSuccess:
+		return "success"
+	case resultBanned:
+		return "banned"
+	case resultUnknownAccount:
+		return "unknown_account"
+	case resultIncorrectPassword:
+		return "incorrect_password"
+	case resultAlreadyOnline:
+		return "already_online"
+	case resultVersionInvalid:
+		return "version_invalid"
+	case resultVersionUpdate:
+		return "version_update"
+	case resultSuspended:
+		return "suspended"
+	case resultFailNoAccess:
+		return "fail_no_access"
+	case resultSessionExpired:
+		return "session_expired"
+	}
+	return fmt.Sprintf("0x%02X", uint8(r))
+}
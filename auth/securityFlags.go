@@ -0,0 +1,13 @@
+package auth
+
+type securityFlags uint8
+
+const (
+	securityFlagPin    securityFlags = 0x01
+	securityFlagMatrix securityFlags = 0x02
+	securityFlagToken  securityFlags = 0x04
+)
+
+func (f securityFlags) has(o securityFlags) bool {
+	return f&o == o
+}
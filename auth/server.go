@@ -1,96 +1,226 @@
 package auth
 
 import (
+	"context"
 	uuid "github.com/satori/go.uuid"
-	"log"
 	xnet "net"
-	"os"
-	"os/signal"
-	"syscall"
+	"sync"
+	"time"
 	"xcore/config"
 	"xcore/core/db"
+	"xcore/core/logger"
+	"xcore/core/models"
 	"xcore/core/net"
+	"xcore/utils"
 )
 
 type server struct {
 	config *config.Config
-
-	db      *db.DB
-	accRepo AccountRepository
+	logger logger.Logger
+	// packetLogger logs every received packet at debug level
+	packetLogger logger.Logger
+
+	db            *db.DB
+	accRepo       AccountRepository
+	banRepo       BanRepository
+	charCountRepo CharacterCountRepository
+	onlineRepo    OnlineAccountRepository
+	// keys is nil unless world server runs in the same process
+	keys SessionKeyStore
 
 	tcpServer net.TCPServer
-	realmList *realmProvider
+	realmList RealmProvider
+	bans      *addressBanList
+	limiter   *LoginLimiter
+	sessions  *sessionManager
+	// sessionsWG tracks goroutines of accepted connections
+	sessionsWG sync.WaitGroup
+	logins     *utils.RateCounter
+	startedAt  time.Time
 }
 
-func NewServer(c *config.Config) (net.Server, error) {
-	xdb, err := db.New(c.DBConfig)
+// NewServer creates auth server, shared may be nil when server runs standalone.
+func NewServer(c *config.Config, l logger.Logger, shared *Shared) (net.Server, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	var xdb *db.DB
+	var keys SessionKeyStore
+	var kicks chan struct{}
+	var err error
+	if shared != nil {
+		xdb, keys, kicks = shared.DB, shared.Keys, shared.Kicks
+	} else if xdb, err = db.New(c.DBConfig); err != nil {
+		return nil, err
+	}
+
+	accRepo, err := NewAccountRepository(c, xdb, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	banRepo, err := NewBanRepository(xdb, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	charCountRepo, err := NewCharacterCountRepository(xdb)
 	if err != nil {
 		return nil, err
 	}
 
-	accRepo, err := NewAccountRepository(c, xdb)
+	onlineRepo, err := NewOnlineAccountRepository(xdb, kicks)
 	if err != nil {
 		return nil, err
 	}
 
 	s := new(server)
 	s.config = c
+	s.logger = l.Subsystem("auth")
+	s.packetLogger = l.Subsystem("packet")
 	s.db = xdb
 	s.accRepo = accRepo
+	s.banRepo = banRepo
+	s.charCountRepo = charCountRepo
+	s.onlineRepo = onlineRepo
+	s.keys = keys
 	s.tcpServer = net.NewTCPServer(&net.ServerParameters{
 		OnConnection: s.handleConnection,
 		OnError:      s.handleError,
 	})
-	s.realmList = NewRealmProvider(c)
+
+	if s.realmList, err = NewRealmProvider(c, xdb, s.logger); err != nil {
+		return nil, err
+	}
+
+	if s.bans, err = newAddressBanList(banRepo, c.AddressBansRefreshInterval, s.logger); err != nil {
+		return nil, err
+	}
+	s.limiter = NewLoginLimiter(c.Lockout)
+	s.sessions = newSessionManager()
+	s.logins = utils.NewRateCounter(time.Minute)
 
 	return s, nil
 }
 
-func (srv *server) start() error {
+func (srv *server) Start() error {
 	if err := srv.tcpServer.Start(srv.config.AuthServerAddress); err != nil {
 		return err
 	}
 
-	log.Printf("auth server started at `%v`\n", srv.config.AuthServerAddress)
+	srv.startedAt = time.Now()
+	srv.logger.Infof("auth server started at `%v`", srv.config.AuthServerAddress)
 
-	log.Printf("added %v realm(s):", len(srv.config.Realms))
-	for _, r := range srv.config.Realms {
-		log.Printf("#%v \"%v\" at %v", r.ID, r.Name, r.Address)
+	realms := srv.realmList.GetRealms()
+	srv.logger.Infof("added %v realm(s) from %v:", len(realms), srv.config.RealmSource)
+	for _, r := range realms {
+		srv.logger.Infof("#%v \"%v\" at %v", r.ID, r.Name, r.Address)
 	}
 
 	return nil
 }
 
-func (srv *server) stop() error {
+func (srv *server) Stop(ctx context.Context) error {
 	if err := srv.tcpServer.Stop(); err != nil {
 		return err
 	}
 
-	log.Println("auth server stopped")
-	return nil
+	srv.sessions.closeAll()
+	err := utils.WaitContext(ctx, &srv.sessionsWG)
+	if err != nil {
+		srv.logger.Warnf("auth sessions did not finish before deadline: %v", err)
+	}
+	srv.realmList.Close()
+	srv.bans.close()
+
+	srv.logger.Infof("auth server stopped")
+	return err
 }
 
-func (srv *server) Run() error {
-	if err := srv.start(); err != nil {
+func (srv *server) Reload(c *config.Config) error {
+	if err := srv.realmList.Reload(c); err != nil {
 		return err
 	}
-	srv.waitExitSignal()
-	return srv.stop()
+	srv.limiter.SetConfig(c.Lockout)
+	if err := srv.bans.refresh(); err != nil {
+		return err
+	}
+	if err := srv.accRepo.CreateDevAccounts(c.DevAccounts); err != nil {
+		return err
+	}
+
+	srv.logger.Infof("auth server reloaded, %v realm(s) available", len(srv.realmList.GetRealms()))
+	return nil
 }
 
+func (srv *server) Info() net.ServerInfo {
+	return net.ServerInfo{
+		Name:            "auth",
+		Address:         srv.config.AuthServerAddress,
+		StartedAt:       srv.startedAt,
+		Sessions:        srv.sessions.count(),
+		LoginsPerMinute: srv.logins.Count(),
+	}
+}
+
+func (srv *server) Sessions() []net.SessionInfo {
+	var sessions []net.SessionInfo
+	srv.sessions.forEach(func(s *session) {
+		sessions = append(sessions, s.info())
+	})
+	return sessions
+}
+
+func (srv *server) KickSession(id string) bool {
+	s := srv.sessions.find(id)
+	if s == nil {
+		return false
+	}
+	s.getLogger().Infof("auth session kicked from console")
+	s.close()
+	return true
+}
+
+// KickAccount does nothing, account of auth session is checked again on reconnect.
+func (srv *server) KickAccount(accountID uint) bool {
+	return false
+}
+
+// BroadcastServerMessage does nothing, players are never in world on auth server.
+func (srv *server) BroadcastServerMessage(t net.ServerMessageType, text string) {}
+
 func (srv *server) handleConnection(conn *xnet.TCPConn) {
+	connectionsAccepted.Inc()
 	id := uuid.NewV4().String()
-	s := newSession(id, conn, srv.accRepo, srv.realmList)
-	go s.authorize()
+	s := newSession(id, conn, srv)
+
+	if ban := srv.bans.find(conn.RemoteAddr().(*xnet.TCPAddr).IP); ban != nil {
+		s.logger.Infof("auth session rejected, address is banned by %v (%v)", ban.Address, ban.Reason)
+		srv.goSession(func() { s.reject(resultBanned) })
+		return
+	}
+
+	srv.goSession(s.authorize)
 }
 
-func (srv *server) handleError(err error) {
-	log.Println(err)
+func (srv *server) goSession(f func()) {
+	srv.sessionsWG.Add(1)
+	go func() {
+		defer srv.sessionsWG.Done()
+		f()
+	}()
+}
+
+func (srv *server) isRealmOnline(id uint8) bool {
+	for _, r := range srv.realmList.GetRealms() {
+		if r.ID == id {
+			return !r.Flag.Has(models.RealmFlagOffline)
+		}
+	}
+	return false
 }
 
-func (srv *server) waitExitSignal() {
-	c := make(chan os.Signal)
-	signal.Notify(c, syscall.SIGTERM)
-	signal.Notify(c, syscall.SIGINT)
-	<-c
+func (srv *server) handleError(err error) {
+	srv.logger.Errorf("auth listener error: %v", err)
 }
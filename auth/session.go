@@ -6,15 +6,20 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"go.uber.org/atomic"
 	"io"
 	"log"
 	"math/big"
 	goNet "net"
-	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"xcore/config"
+	"xcore/core/logger"
 	"xcore/core/models"
 	"xcore/core/net"
 	"xcore/core/srp"
+	"xcore/core/totp"
 	"xcore/utils"
 )
 
@@ -25,19 +30,46 @@ var (
 type sessionStatus uint8
 
 const (
-	logonChallengeStatus sessionStatus = iota
+	logonChallengeStatus sessionStatus = 1 << iota
 	logonProofStatus
 	authorizedStatus
 	reconnectProofStatus
+	patchTransferStatus  // patch is offered, waiting for client to accept, resume or cancel it
+	patchStreamingStatus // patch data is being sent, client may only cancel
 	closedStatus
 )
 
+func (st sessionStatus) has(o sessionStatus) bool {
+	return st&o == o
+}
+
+func (st sessionStatus) String() string {
+	switch st {
+	case logonChallengeStatus:
+		return "logon challenge"
+	case logonProofStatus:
+		return "logon proof"
+	case authorizedStatus:
+		return "authorized"
+	case reconnectProofStatus:
+		return "reconnect proof"
+	case patchTransferStatus:
+		return "patch transfer"
+	case patchStreamingStatus:
+		return "patch streaming"
+	case closedStatus:
+		return "closed"
+	}
+	return "unknown"
+}
+
 const (
 	realmListMsgSize      = 4
 	reconnectProofMsgSize = 57
 )
 
 type sessionHandler struct {
+	// status is set of session statuses handler is allowed in
 	status  sessionStatus
 	msgSize int
 	handler func(s *session) error
@@ -48,19 +80,32 @@ var versionChallenge []uint8
 
 type Session interface {
 	authorize()
+	reject(result result)
 }
 
 type session struct {
 	sock *net.Socket
+	srv  *server
 
-	id      string
-	status  sessionStatus
-	account *models.Account
+	// mu guards account and loggers which are replaced on logon and read by console,
+	// the session goroutine itself reads them without lock
+	mu           sync.RWMutex
+	logger       logger.Logger
+	packetLogger logger.Logger
+	account      *models.Account
+
+	id     string
+	status atomic.Uint32 // sessionStatus, written by console on kick
+	build  uint16
 
-	accRepo    AccountRepository
-	realmList  *realmProvider
 	srp        *srp.SRP
 	reconProof *big.Int
+	patch      *patch
+	xferCancel chan struct{}
+
+	// handler timing is finished when next packet is awaited, handlers continue auth recursively
+	handlerOp    opcode
+	handlerStart time.Time
 }
 
 func init() {
@@ -90,25 +135,75 @@ func init() {
 			msgSize: reconnectProofMsgSize,
 			handler: (*session).handleReconnectProofOpcode,
 		},
+		xferAcceptOpcode: {
+			status:  patchTransferStatus,
+			msgSize: 0,
+			handler: (*session).handleXferAcceptOpcode,
+		},
+		xferResumeOpcode: {
+			status:  patchTransferStatus,
+			msgSize: xferResumeMsgSize,
+			handler: (*session).handleXferResumeOpcode,
+		},
+		xferCancelOpcode: {
+			status:  patchTransferStatus | patchStreamingStatus,
+			msgSize: 0,
+			handler: (*session).handleXferCancelOpcode,
+		},
 	}
 	versionChallenge = []uint8{0xBA, 0xA3, 0x1E, 0x99, 0xA0, 0x0B, 0x21, 0x57, 0xFC, 0x37, 0x3F, 0xB3, 0x69, 0xCD, 0xD2, 0xF1}
 }
 
-func newSession(id string, conn *goNet.TCPConn, accRepo AccountRepository, rs *realmProvider) Session {
-	sock := net.NewSocket(conn)
-	sock.OnClose(func(err error) {
+func newSession(id string, conn *goNet.TCPConn, srv *server) *session {
+	s := &session{
+		sock: net.NewSocket(conn),
+		srv:  srv,
+		id:   id,
+	}
+	s.setStatus(logonChallengeStatus)
+	s.logger = srv.logger.With("session", id, "remote", conn.RemoteAddr().String())
+	s.packetLogger = srv.packetLogger.With("session", id, "remote", conn.RemoteAddr().String())
+
+	s.sock.OnClose(func(err error) {
+		// socket may be closed from console or patch streaming goroutine
 		if err != nil {
-			log.Printf("Auth session [%v] closed with error: %v", id, err)
+			s.getLogger().Warnf("auth session closed with error: %v", err)
 		} else {
-			log.Printf("Auth session [%v] closed", id)
+			s.getLogger().Infof("auth session closed")
 		}
 	})
-	return &session{
-		sock:      sock,
-		id:        id,
-		accRepo:   accRepo,
-		realmList: rs,
-	}
+	return s
+}
+
+// setAccount binds account to session and its log lines.
+func (s *session) setAccount(acc *models.Account) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.account = acc
+	s.logger = s.logger.With("account", acc.Name)
+	s.packetLogger = s.packetLogger.With("account", acc.Name)
+}
+
+// getAccount is safe to call from any goroutine.
+func (s *session) getAccount() *models.Account {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.account
+}
+
+// getLogger is safe to call from any goroutine.
+func (s *session) getLogger() logger.Logger {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.logger
+}
+
+func (s *session) getStatus() sessionStatus {
+	return sessionStatus(s.status.Load())
+}
+
+func (s *session) setStatus(st sessionStatus) {
+	s.status.Store(uint32(st))
 }
 
 func (s *session) initSRP() error {
@@ -132,7 +227,14 @@ func (s *session) initSRP() error {
 }
 
 func (s *session) authorize() {
-	log.Printf("Auth session [%v] started (%v)", s.id, s.sock.RemoteAddr())
+	s.logger.Infof("auth session started")
+
+	if !s.srv.sessions.add(s) {
+		s.logger.Infof("auth session closed, server is stopping")
+		s.close()
+		return
+	}
+	defer s.srv.sessions.remove(s)
 
 	if err := s.continueAuth(); err != nil {
 		if err := s.sock.Close(); err != nil {
@@ -141,24 +243,39 @@ func (s *session) authorize() {
 	}
 }
 
+// reject answers logon challenge with result and closes session.
+func (s *session) reject(result result) {
+	if err := s.sock.ReceiveData(); err == nil {
+		if err := s.closeWithResult(result, logonChallengeOpcode); err != nil {
+			s.logger.Warnf("auth session rejection failed: %v", err)
+		}
+	}
+
+	if err := s.sock.Close(); err != nil {
+		s.logger.Warnf("can not close auth session: %v", err)
+	}
+}
+
 func (s *session) continueAuth() error {
+	s.finishHandlerTiming()
+
 	err := s.sock.ReceiveData()
 	if err == io.EOF {
-		s.status = closedStatus
+		s.setStatus(closedStatus)
 		return s.sock.Close()
 	}
 
 	opRaw, err := s.sock.ReadByte()
 	if err == io.EOF {
-		s.status = closedStatus
+		s.setStatus(closedStatus)
 		return nil
 	}
 
 	op := opcode(opRaw)
 	h := sessionHandlers[op]
-	if h == nil || h.status != s.status {
-		s.status = closedStatus
-		log.Printf("Received unexpected opcode: %v", op)
+	if st := s.getStatus(); h == nil || !h.status.has(st) {
+		s.setStatus(closedStatus)
+		s.logger.Warnf("received unexpected opcode %v in status %v", op, st)
 		return errUnexpectedOpcode
 	}
 
@@ -166,20 +283,32 @@ func (s *session) continueAuth() error {
 	recvSize := s.sock.ReadBufferSize()
 
 	if recvSize < msgSize {
-		log.Printf("Received malformed packed with %d size, but expected %d size", recvSize+1, msgSize)
-		s.status = closedStatus
+		s.logger.Warnf("received malformed packet %v with %d size, but expected %d size", op, recvSize+1, msgSize)
+		s.setStatus(closedStatus)
 		return s.sock.Close()
 	}
 
-	log.Printf("Handling opcode %v\n", op)
-	if err := h.handler(s); err != nil {
-		s.status = closedStatus
+	s.packetLogger.Debugf("handling opcode %v (%d bytes)", op, recvSize+1)
+	s.handlerOp = op
+	s.handlerStart = time.Now()
+	err = h.handler(s)
+	s.finishHandlerTiming()
+	if err != nil {
+		s.setStatus(closedStatus)
 		return err
 	}
 
 	return nil
 }
 
+func (s *session) finishHandlerTiming() {
+	if s.handlerStart.IsZero() {
+		return
+	}
+	handlerDuration.ObserveSince(s.handlerStart, s.handlerOp.String())
+	s.handlerStart = time.Time{}
+}
+
 func (s *session) handleLogonChallengeOpcode() error {
 	buf, err := s.sock.ReadBytes(logonChallengeSize)
 	if err != nil {
@@ -215,23 +344,81 @@ func (s *session) handleLogonProofOpcode() error {
 		return err
 	}
 
+	if p.securityFlags.has(securityFlagToken) {
+		if p.token, err = s.readSecurityToken(); err != nil {
+			return err
+		}
+	}
+
 	return s.handleLogonProof(p)
 }
 
+func (s *session) readSecurityToken() (string, error) {
+	if err := s.sock.ReceiveDataRecursive(1); err != nil {
+		return "", err
+	}
+
+	size, err := s.sock.ReadByte()
+	if err != nil || size == 0 {
+		return "", err
+	}
+
+	if err := s.sock.ReceiveDataRecursive(int(size)); err != nil {
+		return "", err
+	}
+
+	token, err := s.sock.ReadBytes(int(size))
+	if err != nil {
+		return "", err
+	}
+	return string(token), nil
+}
+
+// validateSecurityToken checks authenticator code, each code is accepted only once.
+func (s *session) validateSecurityToken(logonProof *logonProof) (bool, error) {
+	if !s.account.HasTotpSecret() {
+		return true, nil
+	}
+
+	step, ok := totp.Validate(s.account.TotpSecret.String, logonProof.token, time.Now())
+	if !ok {
+		return false, nil
+	}
+
+	accepted, err := s.srv.accRepo.AcceptTotpStep(s.account.ID, step)
+	if err != nil || !accepted {
+		return false, err
+	}
+	// account is saved with session key later, the step must not be overwritten with the old one
+	s.account.TotpLastStep = step
+	return true, nil
+}
+
 func (s *session) handleRealmListOpcode() error {
+	realmListRequests.Inc()
+
 	_, err := s.sock.ReadBytes(realmListMsgSize)
 	if err != nil {
 		return err
 	}
 
-	count := uint16(s.realmList.GetRealmsCount())
+	var realms []*models.Realm
+	for _, r := range s.srv.realmList.GetRealms() {
+		if r.AcceptsBuild(s.build) || !r.HideOnBuildMismatch {
+			realms = append(realms, r)
+		}
+	}
+
+	charCounts, err := s.srv.charCountRepo.GetCharacterCounts(s.account.ID)
+	if err != nil {
+		return err
+	}
 
 	realmsBuf := utils.NewBuffer()
 	realmsBuf.MustWriteBytes(utils.LittleEndian.UInt32ToBytes(0))
-	realmsBuf.MustWriteBytes(utils.LittleEndian.UInt16ToBytes(count))
+	realmsBuf.MustWriteBytes(utils.LittleEndian.UInt16ToBytes(uint16(len(realms))))
 
-	for i := 0; i < int(count); i++ {
-		r := s.realmList.GetRealm(i)
+	for _, r := range realms {
 		realmsBuf.MustWriteByte(byte(r.Type))
 
 		if r.IsLocked {
@@ -240,7 +427,11 @@ func (s *session) handleRealmListOpcode() error {
 			realmsBuf.MustWriteByte(0)
 		}
 
-		realmsBuf.MustWriteByte(byte(r.Flag))
+		flag := r.Flag
+		if !r.AcceptsBuild(s.build) {
+			flag.Append(models.RealmFlagVersionMismatch)
+		}
+		realmsBuf.MustWriteByte(byte(flag))
 
 		realmsBuf.MustWriteBytes([]byte(r.Name))
 		realmsBuf.MustWriteByte(0)
@@ -251,27 +442,17 @@ func (s *session) handleRealmListOpcode() error {
 		population := float32(r.Population)
 		realmsBuf.MustWriteBytes(utils.LittleEndian.Float32ToBytes(population))
 
-		realmsBuf.MustWriteByte(r.CharactersCount)
+		realmsBuf.MustWriteByte(charCounts[r.ID])
 		realmsBuf.MustWriteByte(byte(r.Timezone))
 
 		realmsBuf.MustWriteByte(r.ID)
 
 		if r.Flag.Has(models.RealmFlagSpecifyBuild) {
-			v := strings.Split(r.Version, ".")
-			var err error
-			vMajor, err := strconv.Atoi(v[0])
-			vMinor, err := strconv.Atoi(v[1])
-			vBugFix, err := strconv.Atoi(v[2])
-			vBuild, err := strconv.Atoi(v[3])
-
-			if err != nil {
-				return err
-			}
-
-			realmsBuf.MustWriteByte(byte(vMajor))
-			realmsBuf.MustWriteByte(byte(vMinor))
-			realmsBuf.MustWriteByte(byte(vBugFix))
-			realmsBuf.MustWriteBytes(utils.LittleEndian.UInt16ToBytes(uint16(vBuild)))
+			v := r.ClientVersion()
+			realmsBuf.MustWriteByte(v.Major)
+			realmsBuf.MustWriteByte(v.Minor)
+			realmsBuf.MustWriteByte(v.BugFix)
+			realmsBuf.MustWriteBytes(utils.LittleEndian.UInt16ToBytes(v.Build))
 		}
 	}
 
@@ -315,7 +496,20 @@ func (s *session) handleReconnectChallengeOpcode() error {
 }
 
 func (s *session) handleLogonChallenge(payload *logonChallenge, accountName string) error {
-	acc, err := s.accRepo.GetAccountWithName(accountName)
+	switch res := s.checkClientBuild(payload); res {
+	case resultSuccess:
+	case resultVersionUpdate:
+		return s.initiatePatchTransfer(payload)
+	default:
+		return s.closeWithResult(res, logonChallengeOpcode)
+	}
+
+	if s.srv.limiter.IsLocked(accountName, s.sock.RemoteIP().String()) {
+		s.logger.Infof("auth session rejected, account %v or address is locked out", accountName)
+		return s.closeWithResult(resultFailNoAccess, logonChallengeOpcode)
+	}
+
+	acc, err := s.srv.accRepo.GetAccountWithName(accountName)
 	if err != nil {
 		return err
 	}
@@ -324,7 +518,15 @@ func (s *session) handleLogonChallenge(payload *logonChallenge, accountName stri
 		return s.closeWithResult(resultUnknownAccount, logonChallengeOpcode)
 	}
 
-	s.account = acc
+	if res, err := s.checkAccountBan(acc); err != nil || res != resultSuccess {
+		if err != nil {
+			return err
+		}
+		return s.closeWithResult(res, logonChallengeOpcode)
+	}
+
+	s.setAccount(acc)
+	s.build = payload.build
 
 	if err := s.initSRP(); err != nil {
 		return err
@@ -363,22 +565,51 @@ func (s *session) handleLogonChallenge(payload *logonChallenge, accountName stri
 	s.sock.MustWriteBytes(N)
 	s.sock.MustWriteBytes(salt)
 	s.sock.MustWriteBytes(versionChallenge)
-	s.sock.MustWriteByte(0) // security flags
+
+	if s.account.HasTotpSecret() {
+		s.sock.MustWriteByte(byte(securityFlagToken))
+		s.sock.MustWriteByte(1) // request token input
+	} else {
+		s.sock.MustWriteByte(0)
+	}
 
 	if err := s.sock.CommitWrite(); err != nil {
 		return err
 	}
 
-	s.status = logonProofStatus
+	s.setStatus(logonProofStatus)
 	return s.continueAuth()
 }
 
 func (s *session) handleLogonProof(logonProof *logonProof) error {
 	accName := strings.ToUpper(s.account.Name)
-	if !s.srp.ValidateClientProof(accName, logonProof.xM1[:], logonProof.xA[:]) {
+	srpValid := s.srp.ValidateClientProof(accName, logonProof.xM1[:], logonProof.xA[:])
+	if !srpValid {
+		srpProofFailures.Inc()
+	}
+
+	valid := srpValid
+	if valid {
+		var err error
+		if valid, err = s.validateSecurityToken(logonProof); err != nil {
+			return err
+		}
+	}
+
+	if !valid {
+		locked := s.srv.limiter.RegisterFailure(accName, s.sock.RemoteIP().String())
+
+		res := resultUnknownAccount
+		if locked {
+			s.logger.Warnf("account and address locked out after failed logins")
+			res = resultFailNoAccess
+			s.setStatus(closedStatus)
+		}
+		logonAttempts.Inc(res.String())
+
 		s.sock.BeginWrite()
 		s.sock.MustWriteByte(byte(logonProofOpcode))
-		s.sock.MustWriteByte(byte(resultUnknownAccount))
+		s.sock.MustWriteByte(byte(res))
 		s.sock.MustWriteByte(3)
 		s.sock.MustWriteByte(0)
 
@@ -389,20 +620,53 @@ func (s *session) handleLogonProof(logonProof *logonProof) error {
 		return s.continueAuth()
 	}
 
+	s.srv.limiter.Reset(accName)
+
+	// policy is applied only after proof, so knowing account name is not enough to kick its session
+	res, err := s.checkDuplicateLogin(s.account)
+	if err != nil {
+		return err
+	}
+	if res != resultSuccess {
+		logonAttempts.Inc(res.String())
+		s.setStatus(closedStatus)
+
+		s.sock.BeginWrite()
+		s.sock.MustWriteByte(byte(logonProofOpcode))
+		s.sock.MustWriteByte(byte(res))
+		s.sock.MustWriteByte(3)
+		s.sock.MustWriteByte(0)
+
+		if err := s.sock.CommitWrite(); err != nil {
+			return err
+		}
+		return s.continueAuth()
+	}
+
+	s.srv.logins.Add()
+	logonAttempts.Inc(resultSuccess.String())
+
 	s.account.SessionKey = sql.NullString{
 		String: s.srp.GetPublicKey().Text(16),
 		Valid:  true,
 	}
-	if err := s.accRepo.SaveAccount(s.account); err != nil {
+	if err := s.srv.accRepo.SaveAccount(s.account); err != nil {
 		return err
 	}
+	if s.srv.keys != nil {
+		s.srv.keys.Put(s.account)
+	}
 
 	s.sock.BeginWrite()
 	s.sock.MustWriteByte(byte(logonProofOpcode))
 	s.sock.MustWriteByte(0) // error
 	s.sock.MustWriteBytes(s.srp.GetProof())
 
-	s.sock.MustWriteUInt32(uint32(accountFlagPropass))
+	flags := accountFlagPropass
+	if s.account.GMLevel > 0 {
+		flags |= accountFlagGM
+	}
+	s.sock.MustWriteUInt32(uint32(flags))
 	s.sock.MustWriteUInt32(0) // survey id
 	s.sock.MustWriteUInt16(0) // login flags
 
@@ -410,12 +674,24 @@ func (s *session) handleLogonProof(logonProof *logonProof) error {
 		return err
 	}
 
-	s.status = authorizedStatus
+	s.setStatus(authorizedStatus)
 	return s.continueAuth()
 }
 
+// handleReconnectChallenge runs the same build, lockout and ban checks as logon challenge,
+// reconnect must not let in account which could not log on.
 func (s *session) handleReconnectChallenge(challenge *logonChallenge, accName string) error {
-	acc, err := s.accRepo.GetAccountWithName(accName)
+	// patch can not be transferred on reconnect
+	if res := s.checkClientBuild(challenge); res != resultSuccess {
+		return s.closeWithResult(resultVersionInvalid, reconnectChallengeOpcode)
+	}
+
+	if s.srv.limiter.IsLocked(accName, s.sock.RemoteIP().String()) {
+		s.logger.Infof("auth session rejected reconnect, account %v or address is locked out", accName)
+		return s.closeWithResult(resultFailNoAccess, reconnectChallengeOpcode)
+	}
+
+	acc, err := s.srv.accRepo.GetAccountWithName(accName)
 	if err != nil {
 		return err
 	}
@@ -424,11 +700,19 @@ func (s *session) handleReconnectChallenge(challenge *logonChallenge, accName st
 		return s.closeWithResult(resultUnknownAccount, reconnectChallengeOpcode)
 	}
 
+	if res, err := s.checkAccountBan(acc); err != nil || res != resultSuccess {
+		if err != nil {
+			return err
+		}
+		return s.closeWithResult(res, reconnectChallengeOpcode)
+	}
+
 	if !acc.SessionKey.Valid {
 		return s.closeWithResult(resultSessionExpired, reconnectChallengeOpcode)
 	}
 
-	s.account = acc
+	s.setAccount(acc)
+	s.build = challenge.build
 	s.reconProof = srp.RandBigInt(16 * 8)
 
 	s.sock.BeginWrite()
@@ -444,7 +728,7 @@ func (s *session) handleReconnectChallenge(challenge *logonChallenge, accName st
 		return err
 	}
 
-	s.status = reconnectProofStatus
+	s.setStatus(reconnectProofStatus)
 	return s.continueAuth()
 }
 
@@ -454,10 +738,12 @@ func (s *session) handleReconnectProofOpcode() error {
 	_ = s.sock.MustReadBytes(20) // R3 (unused)
 	_ = s.sock.MustReadByte()    // number of keys (unused)
 
-	failure := func() error {
+	accName := strings.ToUpper(s.account.Name)
+	reject := func(res result) error {
+		logonAttempts.Inc(res.String())
 		s.sock.BeginWrite()
 		s.sock.MustWriteByte(byte(reconnectProofOpcode))
-		s.sock.MustWriteByte(byte(resultUnknownAccount))
+		s.sock.MustWriteByte(byte(res))
 		s.sock.MustWriteByte(3)
 		s.sock.MustWriteByte(0)
 
@@ -467,6 +753,15 @@ func (s *session) handleReconnectProofOpcode() error {
 
 		return s.continueAuth()
 	}
+	// failed proofs are counted like failed logon proofs, reconnect gives no extra guesses
+	failure := func() error {
+		if s.srv.limiter.RegisterFailure(accName, s.sock.RemoteIP().String()) {
+			s.logger.Warnf("account and address locked out after failed reconnects")
+			s.setStatus(closedStatus)
+			return reject(resultFailNoAccess)
+		}
+		return reject(resultUnknownAccount)
+	}
 
 	if !s.account.SessionKey.Valid {
 		return failure()
@@ -475,7 +770,7 @@ func (s *session) handleReconnectProofOpcode() error {
 	K := srp.NewBigIntWithHex(s.account.SessionKey.String)
 
 	h := sha1.New()
-	h.Write([]byte(strings.ToUpper(s.account.Name)))
+	h.Write([]byte(accName))
 	h.Write(R1)
 	h.Write(utils.ReversedBytes(s.reconProof.Bytes()))
 	h.Write(utils.ReversedBytes(K.Bytes()))
@@ -485,6 +780,22 @@ func (s *session) handleReconnectProofOpcode() error {
 		return failure()
 	}
 
+	s.srv.limiter.Reset(accName)
+
+	res, err := s.checkDuplicateLogin(s.account)
+	if err != nil {
+		return err
+	}
+	if res != resultSuccess {
+		s.setStatus(closedStatus)
+		return reject(res)
+	}
+
+	logonAttempts.Inc(resultSuccess.String())
+	if s.srv.keys != nil {
+		s.srv.keys.Put(s.account)
+	}
+
 	s.sock.BeginWrite().
 		MustWriteByte(byte(reconnectProofOpcode)).
 		MustWriteByte(0).
@@ -494,13 +805,69 @@ func (s *session) handleReconnectProofOpcode() error {
 		return err
 	}
 
-	s.status = authorizedStatus
+	s.setStatus(authorizedStatus)
 
 	return s.continueAuth()
 }
 
+func (s *session) checkClientBuild(payload *logonChallenge) result {
+	b := s.srv.config.FindClientBuild(payload.build)
+	if b == nil {
+		s.logger.Infof("auth session rejected unknown client build %v.%v.%v.%v",
+			payload.version[0], payload.version[1], payload.version[2], payload.build)
+		return resultVersionInvalid
+	}
+
+	if !b.IsAccepted() {
+		s.logger.Infof("client build %v has to be updated to %v", b.Build, b.PatchTo)
+		return resultVersionUpdate
+	}
+
+	return resultSuccess
+}
+
+// checkAccountBan returns resultBanned or resultSuspended for account with active ban and resultSuccess otherwise.
+func (s *session) checkAccountBan(acc *models.Account) (result, error) {
+	ban, err := s.srv.banRepo.GetActiveAccountBan(acc.ID)
+	if err != nil {
+		return resultSuccess, err
+	}
+
+	if ban == nil {
+		return resultSuccess, nil
+	}
+
+	s.logger.Infof("auth session rejected banned account %v", acc.Name)
+	if ban.IsPermanent() {
+		return resultBanned, nil
+	}
+	return resultSuspended, nil
+}
+
+// checkDuplicateLogin applies duplicate login policy if account is already online.
+func (s *session) checkDuplicateLogin(acc *models.Account) (result, error) {
+	online, err := s.srv.onlineRepo.GetOnlineAccount(acc.ID)
+	if err != nil {
+		return resultSuccess, err
+	}
+
+	// online mark of realm which is down is left after world server crash
+	if online == nil || !s.srv.isRealmOnline(online.RealmID) {
+		return resultSuccess, nil
+	}
+
+	if s.srv.config.DuplicateLoginPolicy == config.DuplicateLoginRejectNew {
+		s.logger.Infof("auth session rejected, account %v is already online on realm #%v", acc.Name, online.RealmID)
+		return resultAlreadyOnline, nil
+	}
+
+	s.logger.Infof("auth session kicks account %v from realm #%v", acc.Name, online.RealmID)
+	return resultSuccess, s.srv.onlineRepo.RequestKick(acc.ID)
+}
+
 func (s *session) closeWithResult(result result, command opcode) error {
-	s.status = closedStatus
+	s.setStatus(closedStatus)
+	logonAttempts.Inc(result.String())
 
 	s.sock.BeginWrite().
 		MustWriteByte(byte(command)).
@@ -514,6 +881,25 @@ func (s *session) closeWithResult(result result, command opcode) error {
 	return s.continueAuth()
 }
 
+func (s *session) info() net.SessionInfo {
+	i := net.SessionInfo{
+		ID:         s.id,
+		RemoteAddr: s.sock.RemoteAddr(),
+		Status:     s.getStatus().String(),
+	}
+	if acc := s.getAccount(); acc != nil {
+		i.Account = acc.Name
+	}
+	return i
+}
+
+func (s *session) close() {
+	s.setStatus(closedStatus)
+	if err := s.sock.Close(); err != nil {
+		s.getLogger().Warnf("can not close auth session: %v", err)
+	}
+}
+
 func (s *session) createAndSaveAuthToken() error {
 	verifier := s.srp.GetVerifier().Text(16)
 	salt := s.srp.GetSalt().Text(16)
@@ -523,7 +909,7 @@ func (s *session) createAndSaveAuthToken() error {
 		String: fmt.Sprintf("%v:%v", verifier, salt),
 	}
 
-	if err := s.accRepo.SaveAccount(s.account); err != nil {
+	if err := s.srv.accRepo.SaveAccount(s.account); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"strings"
+	"sync"
+	"time"
+	"xcore/core/db"
+	"xcore/core/models"
+)
+
+// sessionKeyTTL bounds how long issued key is kept, world session is expected to be started shortly after logon
+// or reconnect, both of which store the account again.
+const sessionKeyTTL = time.Minute * 10
+
+// Shared holds resources shared by auth and world servers running in one process.
+type Shared struct {
+	DB   *db.DB
+	Keys SessionKeyStore
+	// Kicks wakes world server up when kick is requested, it has to be buffered.
+	Kicks chan struct{}
+}
+
+// SessionKeyStore keeps accounts with session keys issued by auth server for a limited time,
+// so world server of the same process can authorize sessions without database round-trip.
+// Repositories created with the store evict account whenever it is changed, deleted or banned.
+type SessionKeyStore interface {
+	// Put stores copy of account with its current session key.
+	Put(acc *models.Account)
+	// Get returns copy of account stored with name or nil if it is missing or expired.
+	Get(name string) *models.Account
+	// Evict removes account with id.
+	Evict(accountID uint)
+}
+
+type storedAccount struct {
+	account models.Account
+	expires time.Time
+}
+
+type sessionKeyStore struct {
+	mu        sync.Mutex
+	accounts  map[string]storedAccount
+	lastSweep time.Time
+}
+
+func NewSessionKeyStore() SessionKeyStore {
+	return &sessionKeyStore{
+		accounts:  map[string]storedAccount{},
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *sessionKeyStore) Put(acc *models.Account) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.accounts[strings.ToUpper(acc.Name)] = storedAccount{account: *acc, expires: now.Add(sessionKeyTTL)}
+
+	if now.Sub(s.lastSweep) < sessionKeyTTL {
+		return
+	}
+	for name, a := range s.accounts {
+		if now.After(a.expires) {
+			delete(s.accounts, name)
+		}
+	}
+	s.lastSweep = now
+}
+
+func (s *sessionKeyStore) Get(name string) *models.Account {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name = strings.ToUpper(name)
+	a, ok := s.accounts[name]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(a.expires) {
+		delete(s.accounts, name)
+		return nil
+	}
+	return &a.account
+}
+
+func (s *sessionKeyStore) Evict(accountID uint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, a := range s.accounts {
+		if a.account.ID == accountID {
+			delete(s.accounts, name)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package auth
+
+import "sync"
+
+type sessionManager struct {
+	mu       sync.RWMutex
+	sessions map[string]*session
+	closed   bool
+}
+
+func newSessionManager() *sessionManager {
+	return &sessionManager{
+		sessions: map[string]*session{},
+	}
+}
+
+// add returns false if manager is closed and session must not be started.
+func (m *sessionManager) add(s *session) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return false
+	}
+	m.sessions[s.id] = s
+	sessionsActive.Set(float64(len(m.sessions)))
+	return true
+}
+
+func (m *sessionManager) remove(s *session) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.sessions, s.id)
+	sessionsActive.Set(float64(len(m.sessions)))
+}
+
+func (m *sessionManager) count() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.sessions)
+}
+
+func (m *sessionManager) forEach(f func(s *session)) {
+	m.mu.RLock()
+	sessions := make([]*session, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		sessions = append(sessions, s)
+	}
+	m.mu.RUnlock()
+
+	for _, s := range sessions {
+		f(s)
+	}
+}
+
+func (m *sessionManager) find(id string) *session {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.sessions[id]
+}
+
+// closeAll closes active sessions and rejects sessions added later.
+func (m *sessionManager) closeAll() {
+	m.mu.Lock()
+	m.closed = true
+	m.mu.Unlock()
+
+	m.forEach((*session).close)
+}
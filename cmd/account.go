@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/spf13/cobra"
+	"strconv"
+	"text/tabwriter"
+	"xcore/auth"
+)
+
+var accountCmd = &cobra.Command{
+	Use:   "account",
+	Short: "Manage accounts",
+}
+
+var accountCreateCmd = &cobra.Command{
+	Use:         "create <name> <password>",
+	Short:       "Create account",
+	Args:        cobra.ExactArgs(2),
+	Annotations: map[string]string{annotationSecret: ""},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		repo, err := accountRepository()
+		if err != nil {
+			return err
+		}
+
+		if err := repo.CreateAccount(args[0], args[1]); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "account %v created\n", args[0])
+		return nil
+	},
+}
+
+var accountDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete account",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		repo, err := accountRepository()
+		if err != nil {
+			return err
+		}
+
+		acc, err := repo.GetAccountWithName(args[0])
+		if err != nil {
+			return err
+		}
+		if acc == nil {
+			return auth.ErrAccountNotFound
+		}
+
+		if err := repo.DeleteAccount(acc.Name); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "account %v deleted\n", args[0])
+		if kickAccount(acc.ID) {
+			fmt.Fprintf(cmd.OutOrStdout(), "session of account %v kicked\n", args[0])
+		}
+		return nil
+	},
+}
+
+var accountPasswordCmd = &cobra.Command{
+	Use:         "password <name> <password>",
+	Short:       "Change account password",
+	Args:        cobra.ExactArgs(2),
+	Annotations: map[string]string{annotationSecret: ""},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		repo, err := accountRepository()
+		if err != nil {
+			return err
+		}
+
+		if err := repo.SetAccountPassword(args[0], args[1]); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "password of account %v changed\n", args[0])
+		return nil
+	},
+}
+
+var accountListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List accounts",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		repo, err := accountRepository()
+		if err != nil {
+			return err
+		}
+
+		accounts, err := repo.GetAccounts()
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tNAME\tGM LEVEL\tCREATED")
+		for _, a := range accounts {
+			fmt.Fprintf(w, "%v\t%v\t%v\t%v\n", a.ID, a.Name, a.GMLevel, a.CreatedAt.Format("2006-01-02 15:04"))
+		}
+		return w.Flush()
+	},
+}
+
+var accountShowCmd = &cobra.Command{
+	Use:   "show <name>",
+	Short: "Show account details",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		repo, err := accountRepository()
+		if err != nil {
+			return err
+		}
+
+		acc, err := repo.GetAccountWithName(args[0])
+		if err != nil {
+			return err
+		}
+		if acc == nil {
+			return auth.ErrAccountNotFound
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+		fmt.Fprintf(w, "id:\t%v\n", acc.ID)
+		fmt.Fprintf(w, "name:\t%v\n", acc.Name)
+		fmt.Fprintf(w, "gm level:\t%v\n", acc.GMLevel)
+		fmt.Fprintf(w, "authenticator:\t%v\n", acc.HasTotpSecret())
+		fmt.Fprintf(w, "created:\t%v\n", acc.CreatedAt.Format("2006-01-02 15:04:05"))
+		fmt.Fprintf(w, "updated:\t%v\n", acc.UpdatedAt.Format("2006-01-02 15:04:05"))
+		return w.Flush()
+	},
+}
+
+var accountGMLevelCmd = &cobra.Command{
+	Use:   "gmlevel <name> <level>",
+	Short: "Set account GM level",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		level, err := strconv.ParseUint(args[1], 10, 8)
+		if err != nil {
+			return errInvalidArgs
+		}
+
+		repo, err := accountRepository()
+		if err != nil {
+			return err
+		}
+
+		if err := repo.SetAccountGMLevel(args[0], uint8(level)); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "gm level of account %v set to %v\n", args[0], level)
+		return nil
+	},
+}
+
+func init() {
+	accountCmd.AddCommand(
+		accountCreateCmd,
+		accountDeleteCmd,
+		accountPasswordCmd,
+		accountListCmd,
+		accountShowCmd,
+		accountGMLevelCmd,
+	)
+}
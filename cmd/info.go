@@ -1,13 +1,42 @@
 package cmd
 
 import (
+	"fmt"
 	"github.com/spf13/cobra"
+	"runtime"
+	"text/tabwriter"
+	"time"
 )
 
 var infoCmd = &cobra.Command{
-	Use:       "info",
-	ValidArgs: []string{"info"},
+	Use:   "info",
+	Short: "Show server status",
+	Args:  cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		println("info command")
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+
+		srvs := servers()
+		if len(srvs) == 0 {
+			fmt.Fprintln(w, "no running servers")
+		}
+		for _, s := range srvs {
+			i := s.Info()
+			fmt.Fprintf(w, "%v server:\t%v\n", i.Name, i.Address)
+			fmt.Fprintf(w, "  uptime:\t%v\n", i.Uptime().Truncate(time.Second))
+			fmt.Fprintf(w, "  sessions:\t%v\n", i.Sessions)
+			fmt.Fprintf(w, "  logins per minute:\t%v\n", i.LoginsPerMinute)
+			if i.Motd != "" {
+				fmt.Fprintf(w, "  motd:\t%v\n", i.Motd)
+			}
+		}
+
+		var m runtime.MemStats
+		runtime.ReadMemStats(&m)
+		fmt.Fprintf(w, "memory:\t\n")
+		fmt.Fprintf(w, "  allocated:\t%v KiB\n", m.Alloc/1024)
+		fmt.Fprintf(w, "  system:\t%v KiB\n", m.Sys/1024)
+		fmt.Fprintf(w, "  gc cycles:\t%v\n", m.NumGC)
+		fmt.Fprintf(w, "  goroutines:\t%v\n", runtime.NumGoroutine())
+		w.Flush()
 	},
 }
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/spf13/cobra"
+	"sync"
+	"xcore/config"
+	"xcore/core/logger"
+)
+
+var (
+	reloadMu   sync.Mutex
+	rootLogger logger.Logger
+)
+
+// SetLogger sets logger which levels and format are updated on reload.
+func SetLogger(l logger.Logger) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	rootLogger = l
+}
+
+// Reload re-reads configuration and applies settings which can change at runtime to running servers.
+// Names of changed settings which require restart are returned.
+func Reload() ([]string, error) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	srvs := servers()
+	if len(srvs) == 0 {
+		return nil, errNoRunningServers
+	}
+
+	c, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	static := config.Current().StaticChanges(c)
+	if rootLogger != nil {
+		if err := rootLogger.Reload(c.Log); err != nil {
+			return nil, err
+		}
+		for _, name := range static {
+			rootLogger.Warnf("setting %v can not be changed at runtime, restart is required", name)
+		}
+	}
+	config.SetCurrent(c)
+
+	for _, srv := range srvs {
+		if err := srv.Reload(c); err != nil {
+			return static, fmt.Errorf("can not reload %v server: %v", srv.Info().Name, err)
+		}
+	}
+	return static, nil
+}
+
+var reloadCmd = &cobra.Command{
+	Use:   "reload",
+	Short: "Reload configuration of running servers",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		static, err := Reload()
+		for _, name := range static {
+			fmt.Fprintf(cmd.OutOrStdout(), "warning: %v can not be changed at runtime, restart is required\n", name)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), "configuration reloaded")
+		return nil
+	},
+}
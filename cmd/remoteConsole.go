@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"go.uber.org/atomic"
+	"io"
+	"log"
+	xnet "net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"xcore/auth"
+	"xcore/config"
+	"xcore/core/logger"
+	"xcore/core/net"
+)
+
+const (
+	remoteConsolePrompt      = "xcore> "
+	remoteConsoleMaxAttempts = 3
+	remoteConsoleFailDelay   = time.Second
+)
+
+// RemoteConsole lets admins run console commands over TCP.
+type RemoteConsole interface {
+	Start() error
+	Stop() error
+}
+
+type remoteConsole struct {
+	config    *config.AdminConsoleConfig
+	logger    logger.Logger
+	tcpServer net.TCPServer
+	// tlsConfig is nil for plain text console
+	tlsConfig *tls.Config
+	// limiter locks out accounts and addresses guessing passwords across connections,
+	// its counters are separate from auth server
+	limiter *auth.LoginLimiter
+
+	audit       *log.Logger
+	auditFile   *os.File
+	connections atomic.Int32
+
+	mu      sync.Mutex
+	conns   map[xnet.Conn]struct{}
+	stopped bool
+	connsWG sync.WaitGroup
+}
+
+func NewRemoteConsole(c *config.Config, l logger.Logger) (RemoteConsole, error) {
+	cc := c.AdminConsole
+
+	var tlsConfig *tls.Config
+	if cc.TLSCert != "" {
+		cert, err := tls.LoadX509KeyPair(cc.TLSCert, cc.TLSKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+	}
+
+	f, err := os.OpenFile(cc.AuditLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	rc := &remoteConsole{
+		config:    cc,
+		logger:    l.Subsystem("console"),
+		tlsConfig: tlsConfig,
+		limiter:   auth.NewLoginLimiter(c.Lockout),
+		audit:     log.New(f, "", log.LstdFlags),
+		auditFile: f,
+		conns:     map[xnet.Conn]struct{}{},
+	}
+	rc.tcpServer = net.NewTCPServer(&net.ServerParameters{
+		OnConnection: rc.handleConnection,
+		OnError: func(err error) {
+			rc.logger.Errorf("remote console error: %v", err)
+		},
+	})
+	return rc, nil
+}
+
+func (rc *remoteConsole) Start() error {
+	if err := rc.tcpServer.Start(rc.config.Address); err != nil {
+		return err
+	}
+	if rc.tlsConfig == nil {
+		rc.logger.Warnf("remote console has no TLS certificate, commands carrying secrets are refused")
+	}
+	rc.logger.Infof("remote console started at `%v`", rc.config.Address)
+	return nil
+}
+
+// Stop closes listener and active connections, audit log is closed after all connections finish.
+func (rc *remoteConsole) Stop() error {
+	if err := rc.tcpServer.Stop(); err != nil {
+		return err
+	}
+
+	rc.mu.Lock()
+	rc.stopped = true
+	for conn := range rc.conns {
+		conn.Close()
+	}
+	rc.mu.Unlock()
+
+	rc.connsWG.Wait()
+	rc.logger.Infof("remote console stopped")
+	return rc.auditFile.Close()
+}
+
+func (rc *remoteConsole) handleConnection(tcpConn *xnet.TCPConn) {
+	if int(rc.connections.Inc()) > rc.config.MaxConnections {
+		rc.connections.Dec()
+		rc.logger.Warnf("remote console rejected %v: too many connections", tcpConn.RemoteAddr())
+		fmt.Fprintln(tcpConn, "too many connections")
+		tcpConn.Close()
+		return
+	}
+
+	var conn xnet.Conn = tcpConn
+	if rc.tlsConfig != nil {
+		conn = tls.Server(tcpConn, rc.tlsConfig)
+	}
+
+	rc.mu.Lock()
+	if rc.stopped {
+		rc.mu.Unlock()
+		rc.connections.Dec()
+		conn.Close()
+		return
+	}
+	rc.conns[conn] = struct{}{}
+	rc.connsWG.Add(1)
+	rc.mu.Unlock()
+
+	go func() {
+		defer rc.connsWG.Done()
+		defer rc.connections.Dec()
+		defer rc.untrack(conn)
+
+		if err := rc.serve(conn); err != nil && err != io.EOF {
+			rc.logger.Warnf("remote console connection %v closed with error: %v", conn.RemoteAddr(), err)
+		}
+	}()
+}
+
+func (rc *remoteConsole) untrack(conn xnet.Conn) {
+	rc.mu.Lock()
+	delete(rc.conns, conn)
+	rc.mu.Unlock()
+	conn.Close()
+}
+
+func (rc *remoteConsole) serve(conn xnet.Conn) error {
+	r := bufio.NewReader(conn)
+	readLine := func() (string, error) {
+		if err := conn.SetReadDeadline(time.Now().Add(rc.config.IdleTimeout)); err != nil {
+			return "", err
+		}
+		line, err := r.ReadString('\n')
+		return strings.TrimSpace(line), err
+	}
+
+	name, err := rc.login(conn, readLine)
+	if err != nil || name == "" {
+		return err
+	}
+
+	for {
+		fmt.Fprint(conn, remoteConsolePrompt)
+		line, err := readLine()
+		if err != nil {
+			return err
+		}
+
+		args := strings.Fields(line)
+		if len(args) == 0 {
+			continue
+		}
+		if args[0] == "quit" || args[0] == "exit" {
+			rc.audit.Printf("%v@%v logged out", name, conn.RemoteAddr())
+			return nil
+		}
+
+		// secrets are never written to audit log, only command and account it targets
+		if secret, path := isSecretCommand(args); secret {
+			if rc.tlsConfig == nil {
+				rc.audit.Printf("%v@%v: refused over plain text: %v", name, conn.RemoteAddr(), path)
+				fmt.Fprintln(conn, "command carries secrets, it is available over TLS console only")
+				continue
+			}
+			rc.audit.Printf("%v@%v: %v", name, conn.RemoteAddr(), path)
+		} else {
+			rc.audit.Printf("%v@%v: %v", name, conn.RemoteAddr(), line)
+		}
+
+		if err := ExecuteWithOutput(args, conn); err != nil {
+			fmt.Fprintln(conn, err)
+		}
+	}
+}
+
+// login returns name of authenticated account or empty string if all attempts failed.
+// Failures are counted by account and address across connections, so reconnecting gives no new attempts.
+func (rc *remoteConsole) login(conn xnet.Conn, readLine func() (string, error)) (string, error) {
+	repo, err := accountRepository()
+	if err != nil {
+		fmt.Fprintln(conn, "console is unavailable")
+		return "", err
+	}
+
+	address, _, err := xnet.SplitHostPort(conn.RemoteAddr().String())
+	if err != nil {
+		return "", err
+	}
+
+	for i := 0; i < remoteConsoleMaxAttempts; i++ {
+		fmt.Fprint(conn, "login: ")
+		name, err := readLine()
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprint(conn, "password: ")
+		password, err := readLine()
+		if err != nil {
+			return "", err
+		}
+
+		if rc.limiter.IsLocked(name, address) {
+			rc.audit.Printf("locked out login as %q from %v", name, conn.RemoteAddr())
+			fmt.Fprintln(conn, "too many failed logins, try again later")
+			return "", nil
+		}
+
+		acc, err := repo.VerifyAccountPassword(name, password)
+		if err != nil {
+			return "", err
+		}
+
+		if acc != nil && acc.GMLevel >= rc.config.MinGMLevel {
+			rc.limiter.Reset(acc.Name)
+			rc.audit.Printf("%v@%v logged in", acc.Name, conn.RemoteAddr())
+			fmt.Fprintf(conn, "logged in as %v, type `quit` to exit\n", acc.Name)
+			return acc.Name, nil
+		}
+
+		rc.audit.Printf("failed login as %q from %v", name, conn.RemoteAddr())
+		if rc.limiter.RegisterFailure(name, address) {
+			rc.logger.Warnf("remote console locked out %q from %v after failed logins", name, address)
+		}
+		time.Sleep(remoteConsoleFailDelay)
+		fmt.Fprintln(conn, "access denied")
+	}
+	return "", nil
+}
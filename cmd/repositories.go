@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"sync"
+	"xcore/auth"
+	"xcore/config"
+	"xcore/core/db"
+)
+
+var (
+	reposOnce sync.Once
+	reposErr  error
+	accRepo   auth.AccountRepository
+	shared    *auth.Shared
+)
+
+// SetShared makes commands use database and key store of servers running in the same process.
+// It has to be called before any command is run.
+func SetShared(s *auth.Shared) {
+	shared = s
+}
+
+// accountRepository lazily connects to database on first command which needs it.
+func accountRepository() (auth.AccountRepository, error) {
+	reposOnce.Do(func() {
+		c := config.Current()
+		if shared != nil {
+			accRepo, reposErr = auth.NewAccountRepository(c, shared.DB, shared.Keys)
+			return
+		}
+
+		xdb, err := db.New(c.DBConfig)
+		if err != nil {
+			reposErr = err
+			return
+		}
+		accRepo, reposErr = auth.NewAccountRepository(c, xdb, nil)
+	})
+	return accRepo, reposErr
+}
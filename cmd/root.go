@@ -5,20 +5,74 @@ import (
 	"fmt"
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
+	"io"
 	"log"
 	"strings"
+	"sync"
 )
 
 var (
-	errInvalidArgs = errors.New("invalid args")
+	errInvalidArgs      = errors.New("invalid args")
+	errNoRunningServers = errors.New("no running servers")
 )
 
+// annotationSecret marks commands which arguments or output carry passwords or secrets,
+// their first argument is always account name.
+const annotationSecret = "secret"
+
+// executeMu serializes commands of local and remote consoles sharing rootCmd.
+var executeMu sync.Mutex
+
 var rootCmd = &cobra.Command{
-	Use: "xcore",
+	Use:           "xcore",
+	SilenceErrors: true,
+	SilenceUsage:  true,
 }
 
 func init() {
 	rootCmd.AddCommand(infoCmd)
+	rootCmd.AddCommand(totpCmd)
+	rootCmd.AddCommand(accountCmd)
+	rootCmd.AddCommand(sessionCmd)
+	rootCmd.AddCommand(reloadCmd)
+	rootCmd.AddCommand(shutdownCmd)
+}
+
+// Execute runs single command non-interactively, e.g. from shell.
+func Execute(args []string) error {
+	return ExecuteWithOutput(args, nil)
+}
+
+// ExecuteWithOutput runs single command writing its output to out, stdout is used if out is nil.
+func ExecuteWithOutput(args []string, out io.Writer) error {
+	executeMu.Lock()
+	defer executeMu.Unlock()
+
+	rootCmd.SetOut(out)
+	rootCmd.SetErr(out)
+	defer rootCmd.SetOut(nil)
+	defer rootCmd.SetErr(nil)
+
+	rootCmd.SetArgs(args)
+	return rootCmd.Execute()
+}
+
+// isSecretCommand reports whether args run command marked with annotationSecret,
+// path of the command and its account argument are returned for audit.
+func isSecretCommand(args []string) (bool, string) {
+	c, rest, err := rootCmd.Find(args)
+	if err != nil {
+		return false, ""
+	}
+	if _, ok := c.Annotations[annotationSecret]; !ok {
+		return false, ""
+	}
+
+	path := strings.TrimPrefix(c.CommandPath(), rootCmd.Name()+" ")
+	if len(rest) > 0 {
+		path += " " + rest[0]
+	}
+	return true, path
 }
 
 func StartCLI() {
@@ -39,8 +93,7 @@ func StartCLI() {
 			log.Println(err)
 		}
 
-		rootCmd.SetArgs(strings.Split(str, " "))
-		if err := rootCmd.Execute(); err != nil {
+		if err := Execute(strings.Fields(str)); err != nil {
 			fmt.Println(err)
 		}
 	}
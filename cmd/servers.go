@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"sync"
+	"xcore/core/net"
+)
+
+var (
+	serversMu      sync.RWMutex
+	runningServers []net.Server
+)
+
+// AddServer makes server available to console commands.
+func AddServer(s net.Server) {
+	serversMu.Lock()
+	defer serversMu.Unlock()
+	runningServers = append(runningServers, s)
+}
+
+func servers() []net.Server {
+	serversMu.RLock()
+	defer serversMu.RUnlock()
+	return append([]net.Server(nil), runningServers...)
+}
+
+// kickAccount closes session of account on servers of the process, it reports whether any was closed.
+// Sessions on servers of other processes are not reached.
+func kickAccount(accountID uint) bool {
+	kicked := false
+	for _, srv := range servers() {
+		if srv.KickAccount(accountID) {
+			kicked = true
+		}
+	}
+	return kicked
+}
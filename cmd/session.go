@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"github.com/spf13/cobra"
+	"text/tabwriter"
+)
+
+var (
+	errSessionNotFound = errors.New("session not found")
+)
+
+var sessionCmd = &cobra.Command{
+	Use:   "session",
+	Short: "Manage sessions of running servers",
+}
+
+var sessionListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List active sessions",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "SERVER\tID\tADDRESS\tACCOUNT\tSTATUS")
+		for _, srv := range servers() {
+			name := srv.Info().Name
+			for _, s := range srv.Sessions() {
+				fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", name, s.ID, s.RemoteAddr, s.Account, s.Status)
+			}
+		}
+		w.Flush()
+	},
+}
+
+var sessionKickCmd = &cobra.Command{
+	Use:   "kick <id>",
+	Short: "Close session",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, srv := range servers() {
+			if srv.KickSession(args[0]) {
+				fmt.Fprintf(cmd.OutOrStdout(), "session %v kicked\n", args[0])
+				return nil
+			}
+		}
+		return errSessionNotFound
+	},
+}
+
+func init() {
+	sessionCmd.AddCommand(sessionListCmd, sessionKickCmd)
+}
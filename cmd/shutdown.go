@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"github.com/spf13/cobra"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"xcore/core/net"
+)
+
+var (
+	errShutdownScheduled    = errors.New("shutdown is already scheduled, cancel it first")
+	errShutdownNotScheduled = errors.New("shutdown is not scheduled")
+)
+
+var (
+	shutdownMu sync.Mutex
+	// shutdownCancel is closed to cancel scheduled shutdown, it is nil when none is scheduled
+	shutdownCancel    chan struct{}
+	shutdownRequested = make(chan struct{})
+	shutdownOnce      sync.Once
+)
+
+// ShutdownRequested returns channel closed when shutdown countdown started from console ends.
+func ShutdownRequested() <-chan struct{} {
+	return shutdownRequested
+}
+
+// ScheduleShutdown starts countdown notifying players, ShutdownRequested is closed when it ends.
+func ScheduleShutdown(d time.Duration) error {
+	return scheduleShutdown(d)
+}
+
+func scheduleShutdown(d time.Duration) error {
+	shutdownMu.Lock()
+	defer shutdownMu.Unlock()
+	if shutdownCancel != nil {
+		return errShutdownScheduled
+	}
+	if len(servers()) == 0 {
+		return errNoRunningServers
+	}
+
+	shutdownCancel = make(chan struct{})
+	go runShutdownCountdown(time.Now().Add(d), shutdownCancel)
+	return nil
+}
+
+func cancelShutdown() error {
+	shutdownMu.Lock()
+	defer shutdownMu.Unlock()
+	if shutdownCancel == nil {
+		return errShutdownNotScheduled
+	}
+
+	close(shutdownCancel)
+	shutdownCancel = nil
+	broadcastServerMessage(net.ServerMessageShutdownCancelled, "")
+	return nil
+}
+
+func runShutdownCountdown(deadline time.Time, cancel <-chan struct{}) {
+	for {
+		left := time.Until(deadline).Round(time.Second)
+		if left <= 0 {
+			requestShutdown(cancel)
+			return
+		}
+
+		broadcastServerMessage(net.ServerMessageShutdownTime, shutdownTimeString(left))
+
+		timer := time.NewTimer(left - nextShutdownAnnouncement(left))
+		select {
+		case <-timer.C:
+		case <-cancel:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// requestShutdown closes shutdownRequested unless countdown was cancelled meanwhile.
+func requestShutdown(cancel <-chan struct{}) {
+	shutdownMu.Lock()
+	defer shutdownMu.Unlock()
+	select {
+	case <-cancel:
+		return
+	default:
+	}
+
+	shutdownCancel = nil
+	shutdownOnce.Do(func() { close(shutdownRequested) })
+}
+
+// nextShutdownAnnouncement returns time left to shutdown when players are notified next time after left,
+// they are notified every minute and more often during the last half of minute.
+func nextShutdownAnnouncement(left time.Duration) time.Duration {
+	if left > time.Minute {
+		return (left - 1) / time.Minute * time.Minute
+	}
+	for _, s := range []time.Duration{30, 15, 10, 5, 4, 3, 2, 1} {
+		if s*time.Second < left {
+			return s * time.Second
+		}
+	}
+	return 0
+}
+
+// shutdownTimeString formats time left like `1 Hour(s) 4 Minute(s) 30 Second(s)`,
+// the client shows it as is after its own `Server shutdown in` text.
+func shutdownTimeString(left time.Duration) string {
+	secs := int64(left.Round(time.Second) / time.Second)
+	days, hours, minutes := secs/86400, secs%86400/3600, secs%3600/60
+	secs %= 60
+
+	var parts []string
+	if days > 0 {
+		parts = append(parts, fmt.Sprintf("%d Day(s)", days))
+	}
+	if hours > 0 {
+		parts = append(parts, fmt.Sprintf("%d Hour(s)", hours))
+	}
+	if minutes > 0 {
+		parts = append(parts, fmt.Sprintf("%d Minute(s)", minutes))
+	}
+	if secs > 0 || len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("%d Second(s)", secs))
+	}
+	return strings.Join(parts, " ")
+}
+
+func broadcastServerMessage(t net.ServerMessageType, text string) {
+	for _, srv := range servers() {
+		srv.BroadcastServerMessage(t, text)
+	}
+}
+
+var shutdownCmd = &cobra.Command{
+	Use:   "shutdown <seconds> | cancel",
+	Short: "Schedule shutdown of running servers notifying players",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if args[0] == "cancel" {
+			if err := cancelShutdown(); err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "shutdown cancelled")
+			return nil
+		}
+
+		seconds, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			return errInvalidArgs
+		}
+		d := time.Duration(seconds) * time.Second
+		if err := scheduleShutdown(d); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "shutdown in %v\n", d)
+		return nil
+	},
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/spf13/cobra"
+	"xcore/auth"
+	"xcore/core/models"
+	"xcore/core/totp"
+)
+
+const totpIssuer = "xcore"
+
+var totpCmd = &cobra.Command{
+	Use:   "totp",
+	Short: "Manage two-factor authentication of accounts",
+}
+
+var totpEnrollCmd = &cobra.Command{
+	Use:         "enroll <account>",
+	Short:       "Generate new authenticator secret for account",
+	Args:        cobra.ExactArgs(1),
+	Annotations: map[string]string{annotationSecret: ""},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		secret, err := totp.GenerateSecret()
+		if err != nil {
+			return err
+		}
+
+		acc, err := setTotpSecret(args[0], sql.NullString{String: secret, Valid: true})
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "secret: %v\n", secret)
+		fmt.Fprintf(cmd.OutOrStdout(), "uri: %v\n", totp.URI(totpIssuer, acc.Name, secret))
+		return nil
+	},
+}
+
+var totpRemoveCmd = &cobra.Command{
+	Use:   "remove <account>",
+	Short: "Remove authenticator secret from account",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		acc, err := setTotpSecret(args[0], sql.NullString{})
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "authenticator removed from %v\n", acc.Name)
+		return nil
+	},
+}
+
+func init() {
+	totpCmd.AddCommand(totpEnrollCmd, totpRemoveCmd)
+}
+
+func setTotpSecret(name string, secret sql.NullString) (*models.Account, error) {
+	repo, err := accountRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := repo.GetAccountWithName(name)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil {
+		return nil, auth.ErrAccountNotFound
+	}
+
+	acc.TotpSecret = secret
+	return acc, repo.SaveAccount(acc)
+}
@@ -0,0 +1,23 @@
+package config
+
+import "time"
+
+// AdminConsoleConfig configures remote administration console.
+// Console speaks plain text unless TLS certificate is set, so it should be bound to trusted interface only.
+// Commands which carry passwords or secrets are refused over plain text connections.
+type AdminConsoleConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Address string `yaml:"address"`
+
+	// MinGMLevel is minimal GM level of account allowed to log in to console.
+	MinGMLevel     uint8         `yaml:"min_gm_level"`
+	MaxConnections int           `yaml:"max_connections"`
+	IdleTimeout    time.Duration `yaml:"idle_timeout"`
+
+	// AuditLog is file which receives every command run from console, secrets are redacted.
+	AuditLog string `yaml:"audit_log"`
+
+	// TLSCert and TLSKey are PEM files, console accepts TLS connections only when both are set.
+	TLSCert string `yaml:"tls_cert"`
+	TLSKey  string `yaml:"tls_key"`
+}
@@ -0,0 +1,22 @@
+package config
+
+type ClientBuildConfig struct {
+	Build   uint16   `yaml:"build"`
+	Version [3]uint8 `yaml:"version"` // major, minor, bugfix
+	// PatchTo is build the client has to be updated to, zero means the build is accepted.
+	PatchTo uint16 `yaml:"patch_to"`
+}
+
+func (b *ClientBuildConfig) IsAccepted() bool {
+	return b.PatchTo == 0
+}
+
+// FindClientBuild returns config of client build or nil if build is unknown.
+func (c *Config) FindClientBuild(build uint16) *ClientBuildConfig {
+	for _, b := range c.ClientBuilds {
+		if b.Build == build {
+			return b
+		}
+	}
+	return nil
+}
@@ -1,48 +1,282 @@
 package config
 
-import "xcore/core/models"
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+	"xcore/core/models"
+)
+
+type RealmSource string
+
+const (
+	RealmSourceConfig RealmSource = "config"
+	RealmSourceDB     RealmSource = "db"
+)
+
+type DuplicateLoginPolicy string
+
+const (
+	// DuplicateLoginRejectNew rejects login of account which is already online.
+	DuplicateLoginRejectNew DuplicateLoginPolicy = "reject_new"
+	// DuplicateLoginKickOld closes existing session of account and lets the new one in.
+	DuplicateLoginKickOld DuplicateLoginPolicy = "kick_old"
+)
 
 type Config struct {
-	AuthServerAddress  string
-	WorldServerAddress string
+	AuthServerAddress  string `yaml:"auth_server_address"`
+	WorldServerAddress string `yaml:"world_server_address"`
+
+	DBConfig *DBConfig      `yaml:"db"`
+	Log      *LogConfig     `yaml:"log"`
+	Lockout  *LockoutConfig `yaml:"lockout"`
+	World    *WorldConfig   `yaml:"world"`
+
+	AdminConsole *AdminConsoleConfig `yaml:"admin_console"`
+	HTTPAPI      *HTTPAPIConfig      `yaml:"http_api"`
+	Metrics      *MetricsConfig      `yaml:"metrics"`
+
+	DuplicateLoginPolicy DuplicateLoginPolicy `yaml:"duplicate_login_policy"`
+	// AddressBansRefreshInterval is how often auth server reloads address bans cached in memory.
+	AddressBansRefreshInterval time.Duration `yaml:"address_bans_refresh_interval"`
+
+	// ShutdownTimeout limits time servers wait for active sessions to finish on shutdown.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// ShutdownGracePeriod is countdown players are notified with when shutdown is requested by signal.
+	ShutdownGracePeriod time.Duration `yaml:"shutdown_grace_period"`
 
-	DBConfig *DBConfig
+	// PatchDir is directory with client patches named `<build>-<platform>-<os>-<locale>.mpq`
+	PatchDir string `yaml:"patch_dir"`
 
-	DevAccounts []*DevAccount
-	Realms      []*RealmConfig
+	DevAccounts  []*DevAccount        `yaml:"dev_accounts"`
+	ClientBuilds []*ClientBuildConfig `yaml:"client_builds"`
+
+	// RealmSource selects where auth server takes realm list from.
+	// Realms are used as is for config source and seed empty realm table for db source.
+	// With either source realm is online only while its world server sends heartbeats.
+	RealmSource           RealmSource    `yaml:"realm_source"`
+	RealmsRefreshInterval time.Duration  `yaml:"realms_refresh_interval"`
+	Realms                []*RealmConfig `yaml:"realms"`
 }
 
+var (
+	currentMu sync.RWMutex
+	current   = Default()
+)
+
+// Current returns config loaded at startup, or defaults if nothing was loaded.
 func Current() *Config {
+	currentMu.RLock()
+	defer currentMu.RUnlock()
+	return current
+}
+
+func SetCurrent(c *Config) {
+	currentMu.Lock()
+	defer currentMu.Unlock()
+	current = c
+}
+
+// Default returns config used for values missing in config file.
+// It contains no credentials, they are expected from file or environment.
+func Default() *Config {
 	return &Config{
 		AuthServerAddress:  "0.0.0.0:3724",
-		WorldServerAddress: "192.168.1.105:8085",
+		WorldServerAddress: "127.0.0.1:8085",
+
+		DuplicateLoginPolicy:       DuplicateLoginKickOld,
+		AddressBansRefreshInterval: time.Second * 10,
+		ShutdownTimeout:            time.Second * 30,
+		ShutdownGracePeriod:        time.Second * 15,
+		PatchDir:                   "patches",
 
+		Log: &LogConfig{
+			Level:  "info",
+			Format: LogFormatText,
+			Levels: map[string]string{
+				// packets are logged at debug level, set to `debug` to enable
+				"packet": "info",
+			},
+		},
 		DBConfig: &DBConfig{
-			Host:     "127.0.0.1",
-			Port:     "5432",
-			User:     "xcore",
-			Password: "xcore",
-			DBName:   "xcore",
+			Host:   "127.0.0.1",
+			Port:   "5432",
+			User:   "xcore",
+			DBName: "xcore",
+		},
+		World: &WorldConfig{
+			RealmID:           1,
+			MaxPlayers:        100,
+			Motd:              "Welcome to xcore",
+			HeartbeatInterval: time.Second * 10,
+			HeartbeatTimeout:  time.Second * 30,
+		},
+		AdminConsole: &AdminConsoleConfig{
+			Enabled:        false,
+			Address:        "127.0.0.1:3443",
+			MinGMLevel:     3,
+			MaxConnections: 4,
+			IdleTimeout:    time.Minute * 10,
+			AuditLog:       "admin_audit.log",
+		},
+		HTTPAPI: &HTTPAPIConfig{
+			Enabled:           false,
+			Address:           "127.0.0.1:8080",
+			AdminToken:        "",
+			AllowRegistration: true,
+		},
+		Metrics: &MetricsConfig{
+			Enabled: true,
+			Address: "127.0.0.1:9100",
+		},
+		Lockout: &LockoutConfig{
+			MaxFailedAttempts: 5,
+			Window:            time.Minute * 10,
+			Duration:          time.Minute * 15,
 		},
-		DevAccounts: []*DevAccount{
+		ClientBuilds: []*ClientBuildConfig{
 			{
-				Name:     "dev",
-				Password: "123",
+				Build:   8606,
+				Version: [3]uint8{2, 4, 3},
 			},
 		},
+		RealmSource:           RealmSourceDB,
+		RealmsRefreshInterval: time.Second * 10,
 		Realms: []*RealmConfig{
 			{
-				ID:              1,
-				Name:            "Test 1",
-				Address:         "192.168.1.105:8085",
-				IsLocked:        false,
-				Type:            models.RealmTypeNormal,
-				Flag:            models.RealmFlagNew,
-				Timezone:        models.RealmTimezoneDevelopment,
-				Population:      models.RealmPopulationLow,
-				CharactersCount: 0,
-				Version:         "2.4.3.8606",
+				ID:         1,
+				Name:       "Test 1",
+				Address:    "127.0.0.1:8085",
+				IsLocked:   false,
+				Type:       models.RealmTypeNormal,
+				Flag:       models.RealmFlagNew,
+				Timezone:   models.RealmTimezoneDevelopment,
+				Population: models.RealmPopulationLow,
+				Version:    "2.4.3.8606",
 			},
 		},
 	}
 }
+
+func (c *Config) Validate() error {
+	if c.DBConfig == nil || c.Log == nil || c.Lockout == nil || c.World == nil ||
+		c.AdminConsole == nil || c.HTTPAPI == nil || c.Metrics == nil {
+		return fmt.Errorf("db, log, lockout, world, admin_console, http_api and metrics sections are required")
+	}
+
+	if err := validateAddress(c.AuthServerAddress); err != nil {
+		return fmt.Errorf("auth_server_address: %v", err)
+	}
+	if err := validateAddress(c.WorldServerAddress); err != nil {
+		return fmt.Errorf("world_server_address: %v", err)
+	}
+	if _, err := strconv.ParseUint(c.DBConfig.Port, 10, 16); err != nil {
+		return fmt.Errorf("db.port: invalid port `%v`", c.DBConfig.Port)
+	}
+
+	switch c.RealmSource {
+	case RealmSourceConfig, RealmSourceDB:
+	default:
+		return fmt.Errorf("realm_source: unknown value `%v`, expected %v or %v", c.RealmSource, RealmSourceConfig, RealmSourceDB)
+	}
+	switch c.DuplicateLoginPolicy {
+	case DuplicateLoginRejectNew, DuplicateLoginKickOld:
+	default:
+		return fmt.Errorf("duplicate_login_policy: unknown value `%v`, expected %v or %v", c.DuplicateLoginPolicy, DuplicateLoginRejectNew, DuplicateLoginKickOld)
+	}
+	if c.AddressBansRefreshInterval <= 0 {
+		return fmt.Errorf("address_bans_refresh_interval: must be positive, got %v", c.AddressBansRefreshInterval)
+	}
+	if c.ShutdownTimeout <= 0 {
+		return fmt.Errorf("shutdown_timeout: must be positive, got %v", c.ShutdownTimeout)
+	}
+	if c.ShutdownGracePeriod < 0 {
+		return fmt.Errorf("shutdown_grace_period: must not be negative, got %v", c.ShutdownGracePeriod)
+	}
+	switch c.Log.Format {
+	case LogFormatText, LogFormatJSON:
+	default:
+		return fmt.Errorf("log.format: unknown value `%v`, expected %v or %v", c.Log.Format, LogFormatText, LogFormatJSON)
+	}
+	if err := validateLogLevel(c.Log.Level); err != nil {
+		return fmt.Errorf("log.level: %v", err)
+	}
+	for name, level := range c.Log.Levels {
+		if err := validateLogLevel(level); err != nil {
+			return fmt.Errorf("log.levels.%v: %v", name, err)
+		}
+	}
+
+	if c.World.HeartbeatInterval <= 0 {
+		return fmt.Errorf("world.heartbeat_interval: must be positive, got %v", c.World.HeartbeatInterval)
+	}
+	if c.World.HeartbeatTimeout <= 0 {
+		return fmt.Errorf("world.heartbeat_timeout: must be positive, got %v", c.World.HeartbeatTimeout)
+	}
+	if c.RealmsRefreshInterval <= 0 {
+		return fmt.Errorf("realms_refresh_interval: must be positive, got %v", c.RealmsRefreshInterval)
+	}
+	if c.Lockout.MaxFailedAttempts < 0 {
+		return fmt.Errorf("lockout.max_failed_attempts: must not be negative, got %v", c.Lockout.MaxFailedAttempts)
+	}
+	if c.Lockout.MaxFailedAttempts > 0 {
+		if c.Lockout.Window <= 0 {
+			return fmt.Errorf("lockout.window: must be positive, got %v", c.Lockout.Window)
+		}
+		if c.Lockout.Duration <= 0 {
+			return fmt.Errorf("lockout.duration: must be positive, got %v", c.Lockout.Duration)
+		}
+	}
+
+	if c.AdminConsole.Enabled {
+		if err := validateAddress(c.AdminConsole.Address); err != nil {
+			return fmt.Errorf("admin_console.address: %v", err)
+		}
+		if (c.AdminConsole.TLSCert == "") != (c.AdminConsole.TLSKey == "") {
+			return fmt.Errorf("admin_console: tls_cert and tls_key must be set together")
+		}
+	}
+	if c.HTTPAPI.Enabled {
+		if err := validateAddress(c.HTTPAPI.Address); err != nil {
+			return fmt.Errorf("http_api.address: %v", err)
+		}
+	}
+	if c.Metrics.Enabled {
+		if err := validateAddress(c.Metrics.Address); err != nil {
+			return fmt.Errorf("metrics.address: %v", err)
+		}
+	}
+
+	for _, b := range c.ClientBuilds {
+		if b.PatchTo != 0 && c.FindClientBuild(b.PatchTo) == nil {
+			return fmt.Errorf("client build %v: patch_to refers to unknown build %v", b.Build, b.PatchTo)
+		}
+	}
+
+	ids := map[byte]bool{}
+	for _, r := range c.Realms {
+		if ids[r.ID] {
+			return fmt.Errorf("realm #%v: duplicate realm id", r.ID)
+		}
+		ids[r.ID] = true
+
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("realm #%v: %v", r.ID, err)
+		}
+	}
+	return nil
+}
+
+// validateAddress checks address is `host:port` with numeric port.
+func validateAddress(address string) error {
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid address `%v`: %v", address, err)
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return fmt.Errorf("invalid port in address `%v`", address)
+	}
+	return nil
+}
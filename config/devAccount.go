@@ -1,6 +1,6 @@
 package config
 
 type DevAccount struct {
-	Name     string
-	Password string
+	Name     string `yaml:"name"`
+	Password string `yaml:"password"`
 }
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// envOverrides maps environment variables to config values they override.
+// Secrets should be passed this way instead of config file.
+var envOverrides = map[string]func(c *Config, v string) error{
+	"XCORE_AUTH_ADDRESS":  func(c *Config, v string) error { c.AuthServerAddress = v; return nil },
+	"XCORE_WORLD_ADDRESS": func(c *Config, v string) error { c.WorldServerAddress = v; return nil },
+	"XCORE_REALM_ID": func(c *Config, v string) error {
+		id, err := strconv.ParseUint(v, 10, 8)
+		c.World.RealmID = uint8(id)
+		return err
+	},
+	"XCORE_DB_HOST":         func(c *Config, v string) error { c.DBConfig.Host = v; return nil },
+	"XCORE_DB_PORT":         func(c *Config, v string) error { c.DBConfig.Port = v; return nil },
+	"XCORE_DB_USER":         func(c *Config, v string) error { c.DBConfig.User = v; return nil },
+	"XCORE_DB_PASSWORD":     func(c *Config, v string) error { c.DBConfig.Password = v; return nil },
+	"XCORE_DB_NAME":         func(c *Config, v string) error { c.DBConfig.DBName = v; return nil },
+	"XCORE_API_ADMIN_TOKEN": func(c *Config, v string) error { c.HTTPAPI.AdminToken = v; return nil },
+	"XCORE_LOG_LEVEL":       func(c *Config, v string) error { c.Log.Level = v; return nil },
+	"XCORE_LOG_FORMAT":      func(c *Config, v string) error { c.Log.Format = LogFormat(v); return nil },
+}
+
+func (c *Config) applyEnv() error {
+	for name, apply := range envOverrides {
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if err := apply(c, v); err != nil {
+			return fmt.Errorf("%v: invalid value `%v`", name, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package config
+
+// HTTPAPIConfig configures embedded HTTP JSON API.
+type HTTPAPIConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Address string `yaml:"address"`
+
+	// AdminToken protects admin endpoints, they are disabled when token is empty.
+	AdminToken        string `yaml:"admin_token"`
+	AllowRegistration bool   `yaml:"allow_registration"`
+}
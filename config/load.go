@@ -0,0 +1,93 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"os"
+)
+
+const (
+	// EnvConfigPath is environment variable with path of config file.
+	EnvConfigPath     = "XCORE_CONFIG"
+	DefaultConfigPath = "xcore.yaml"
+)
+
+// Load reads config file from XCORE_CONFIG or xcore.yaml, applies environment
+// overrides and validates result. Missing default file is not an error.
+func Load() (*Config, error) {
+	path, explicit := os.LookupEnv(EnvConfigPath)
+	if !explicit {
+		path = DefaultConfigPath
+	}
+
+	c, err := LoadFile(path)
+	if os.IsNotExist(err) && !explicit {
+		c = Default()
+		if err := c.applyEnv(); err != nil {
+			return nil, err
+		}
+		return c, c.Validate()
+	}
+	return c, err
+}
+
+// LoadFile reads config from YAML file on top of defaults.
+func LoadFile(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := Default()
+	// strict decoding rejects keys already present in map, default levels are merged back after it
+	defaultLevels := c.Log.Levels
+	c.Log.Levels = nil
+	if err := yaml.UnmarshalStrict(bytes.TrimSpace(data), c); err != nil {
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+	c.applyMissingSections()
+	if c.Log.Levels == nil {
+		c.Log.Levels = map[string]string{}
+	}
+	for name, level := range defaultLevels {
+		if _, ok := c.Log.Levels[name]; !ok {
+			c.Log.Levels[name] = level
+		}
+	}
+
+	if err := c.applyEnv(); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+	return c, nil
+}
+
+// applyMissingSections replaces sections written without value, e.g. `log:`, with defaults.
+func (c *Config) applyMissingSections() {
+	d := Default()
+	if c.DBConfig == nil {
+		c.DBConfig = d.DBConfig
+	}
+	if c.Log == nil {
+		c.Log = d.Log
+	}
+	if c.Lockout == nil {
+		c.Lockout = d.Lockout
+	}
+	if c.World == nil {
+		c.World = d.World
+	}
+	if c.AdminConsole == nil {
+		c.AdminConsole = d.AdminConsole
+	}
+	if c.HTTPAPI == nil {
+		c.HTTPAPI = d.HTTPAPI
+	}
+	if c.Metrics == nil {
+		c.Metrics = d.Metrics
+	}
+}
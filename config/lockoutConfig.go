@@ -0,0 +1,11 @@
+package config
+
+import "time"
+
+type LockoutConfig struct {
+	// MaxFailedAttempts is count of failed logins inside Window after which
+	// account and address are locked out, zero disables lockout.
+	MaxFailedAttempts int           `yaml:"max_failed_attempts"`
+	Window            time.Duration `yaml:"window"`
+	Duration          time.Duration `yaml:"duration"`
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+type LogFormat string
+
+const (
+	LogFormatText LogFormat = "text"
+	LogFormatJSON LogFormat = "json"
+)
+
+type LogConfig struct {
+	// Level is default level, one of `debug`, `info`, `warn` or `error`.
+	Level  string    `yaml:"level"`
+	Format LogFormat `yaml:"format"`
+	// Levels overrides level per subsystem, e.g. `auth`, `world` or `packet`.
+	Levels map[string]string `yaml:"levels"`
+}
+
+// validateLogLevel accepts the same levels as logger.ParseLevel.
+func validateLogLevel(level string) error {
+	switch strings.ToLower(level) {
+	case "", "debug", "info", "warn", "warning", "error":
+		return nil
+	}
+	return fmt.Errorf("unknown level `%v`, expected debug, info, warn or error", level)
+}
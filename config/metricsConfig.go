@@ -0,0 +1,8 @@
+package config
+
+// MetricsConfig configures Prometheus metrics endpoint.
+type MetricsConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Address of HTTP listener serving `/metrics`.
+	Address string `yaml:"address"`
+}
@@ -1,16 +1,109 @@
 package config
 
-import "xcore/core/models"
+import (
+	"fmt"
+	"strings"
+	"xcore/core/models"
+)
 
 type RealmConfig struct {
-	ID              byte
-	Name            string
-	Address         string
-	IsLocked        bool
-	Type            models.RealmType
-	Flag            models.RealmFlag
-	Timezone        models.RealmTimezone
-	Population      models.RealmPopulation
-	CharactersCount byte
-	Version         string
+	ID         byte
+	Name       string
+	Address    string
+	IsLocked   bool
+	Type       models.RealmType
+	Flag       models.RealmFlag
+	Timezone   models.RealmTimezone
+	Population models.RealmPopulation
+	Version    string
+	// AllowedBuilds are client builds accepted in addition to the build of Version.
+	AllowedBuilds       []uint16
+	HideOnBuildMismatch bool
+}
+
+// realmConfigFile is realm as written in config file, enums are referred by names.
+type realmConfigFile struct {
+	ID                  byte     `yaml:"id"`
+	Name                string   `yaml:"name"`
+	Address             string   `yaml:"address"`
+	IsLocked            bool     `yaml:"locked"`
+	Type                string   `yaml:"type"`
+	Flags               []string `yaml:"flags"`
+	Timezone            string   `yaml:"timezone"`
+	Population          string   `yaml:"population"`
+	Version             string   `yaml:"version"`
+	AllowedBuilds       []uint16 `yaml:"allowed_builds"`
+	HideOnBuildMismatch bool     `yaml:"hide_on_build_mismatch"`
+}
+
+func (r *RealmConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	f := realmConfigFile{
+		Type:       "normal",
+		Timezone:   "development",
+		Population: "low",
+	}
+	if err := unmarshal(&f); err != nil {
+		return err
+	}
+
+	var err error
+	if r.Type, err = parseRealmType(f.Type); err != nil {
+		return fmt.Errorf("realm #%v: %v", f.ID, err)
+	}
+	if r.Flag, err = parseRealmFlags(f.Flags); err != nil {
+		return fmt.Errorf("realm #%v: %v", f.ID, err)
+	}
+	if r.Timezone, err = parseRealmTimezone(f.Timezone); err != nil {
+		return fmt.Errorf("realm #%v: %v", f.ID, err)
+	}
+	if r.Population, err = parseRealmPopulation(f.Population); err != nil {
+		return fmt.Errorf("realm #%v: %v", f.ID, err)
+	}
+
+	r.ID = f.ID
+	r.Name = f.Name
+	r.Address = f.Address
+	r.IsLocked = f.IsLocked
+	r.Version = f.Version
+	r.AllowedBuilds = f.AllowedBuilds
+	r.HideOnBuildMismatch = f.HideOnBuildMismatch
+	return nil
+}
+
+func (r *RealmConfig) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("name is empty")
+	}
+	if r.Address != "" {
+		if err := validateAddress(r.Address); err != nil {
+			return err
+		}
+	}
+	_, err := models.ParseClientVersion(r.Version)
+	return err
+}
+
+func (r *RealmConfig) ToModel() *models.Realm {
+	return &models.Realm{
+		ID:         r.ID,
+		Name:       r.Name,
+		Address:    r.Address,
+		IsLocked:   r.IsLocked,
+		Type:       r.Type,
+		Flag:       r.Flag,
+		Timezone:   r.Timezone,
+		Population: r.Population,
+		Version:    r.Version,
+
+		AllowedBuilds:       joinBuilds(r.AllowedBuilds),
+		HideOnBuildMismatch: r.HideOnBuildMismatch,
+	}
+}
+
+func joinBuilds(builds []uint16) string {
+	s := make([]string, 0, len(builds))
+	for _, b := range builds {
+		s = append(s, fmt.Sprint(b))
+	}
+	return strings.Join(s, ",")
 }
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"xcore/core/models"
+)
+
+var realmTypeNames = map[string]models.RealmType{
+	"normal": models.RealmTypeNormal,
+	"pvp":    models.RealmTypePVP,
+	"rp":     models.RealmTypeRP,
+	"rppvp":  models.RealmTypeRPPVP,
+	"ffapvp": models.RealmTypeFFAPVP,
+}
+
+var realmFlagNames = map[string]models.RealmFlag{
+	"version_mismatch": models.RealmFlagVersionMismatch,
+	"offline":          models.RealmFlagOffline,
+	"specify_build":    models.RealmFlagSpecifyBuild,
+	"recommended":      models.RealmFlagRecommended,
+	"new":              models.RealmFlagNew,
+	"full":             models.RealmFlagFull,
+}
+
+var realmTimezoneNames = map[string]models.RealmTimezone{
+	"development":   models.RealmTimezoneDevelopment,
+	"united_states": models.RealmTimezoneUNITEDSTATES,
+	"oceanic":       models.RealmTimezoneOCEANIC,
+	"latin_america": models.RealmTimezoneLATINAMERICA,
+	"korea":         models.RealmTimezoneKOREA,
+	"english":       models.RealmTimezoneENGLISH,
+	"german":        models.RealmTimezoneGERMAN,
+	"french":        models.RealmTimezoneFRENCH,
+	"spanish":       models.RealmTimezoneSPANISH,
+	"russian":       models.RealmTimezoneRussian,
+	"taiwan":        models.RealmTimezoneTAIWAN,
+	"china":         models.RealmTimezoneCHINA,
+	"test_server":   models.RealmTimezoneTESTSERVER,
+	"qa_server":     models.RealmTimezoneQASERVER,
+}
+
+var realmPopulationNames = map[string]models.RealmPopulation{
+	"low":    models.RealmPopulationLow,
+	"medium": models.RealmPopulationMedium,
+	"high":   models.RealmPopulationHigh,
+}
+
+func parseRealmType(name string) (models.RealmType, error) {
+	if v, ok := realmTypeNames[name]; ok {
+		return v, nil
+	}
+	return 0, unknownEnumError("realm type", name, realmTypeNames)
+}
+
+func parseRealmFlags(names []string) (models.RealmFlag, error) {
+	var flags models.RealmFlag
+	for _, name := range names {
+		f, ok := realmFlagNames[name]
+		if !ok {
+			return 0, unknownEnumError("realm flag", name, realmFlagNames)
+		}
+		flags.Append(f)
+	}
+	return flags, nil
+}
+
+func parseRealmTimezone(name string) (models.RealmTimezone, error) {
+	if v, ok := realmTimezoneNames[name]; ok {
+		return v, nil
+	}
+	return 0, unknownEnumError("realm timezone", name, realmTimezoneNames)
+}
+
+func parseRealmPopulation(name string) (models.RealmPopulation, error) {
+	if v, ok := realmPopulationNames[name]; ok {
+		return v, nil
+	}
+	return 0, unknownEnumError("realm population", name, realmPopulationNames)
+}
+
+// unknownEnumError lists allowed names, which are keys of table.
+func unknownEnumError(kind string, name string, table interface{}) error {
+	var names []string
+	for _, k := range reflect.ValueOf(table).MapKeys() {
+		names = append(names, k.String())
+	}
+	sort.Strings(names)
+	return fmt.Errorf("unknown %v `%v`, expected one of: %v", kind, name, strings.Join(names, ", "))
+}
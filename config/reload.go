@@ -0,0 +1,35 @@
+package config
+
+import "reflect"
+
+// StaticChanges returns names of settings which differ in n but are applied only on restart.
+func (c *Config) StaticChanges(n *Config) []string {
+	settings := []struct {
+		name     string
+		old, new interface{}
+	}{
+		{"auth_server_address", c.AuthServerAddress, n.AuthServerAddress},
+		{"world_server_address", c.WorldServerAddress, n.WorldServerAddress},
+		{"db", c.DBConfig, n.DBConfig},
+		{"world.realm_id", c.World.RealmID, n.World.RealmID},
+		{"world.max_players", c.World.MaxPlayers, n.World.MaxPlayers},
+		{"world.heartbeat_interval", c.World.HeartbeatInterval, n.World.HeartbeatInterval},
+		{"admin_console", c.AdminConsole, n.AdminConsole},
+		{"http_api", c.HTTPAPI, n.HTTPAPI},
+		{"metrics", c.Metrics, n.Metrics},
+		{"duplicate_login_policy", c.DuplicateLoginPolicy, n.DuplicateLoginPolicy},
+		{"patch_dir", c.PatchDir, n.PatchDir},
+		{"client_builds", c.ClientBuilds, n.ClientBuilds},
+		{"realm_source", c.RealmSource, n.RealmSource},
+		{"realms_refresh_interval", c.RealmsRefreshInterval, n.RealmsRefreshInterval},
+		{"address_bans_refresh_interval", c.AddressBansRefreshInterval, n.AddressBansRefreshInterval},
+	}
+
+	var changed []string
+	for _, s := range settings {
+		if !reflect.DeepEqual(s.old, s.new) {
+			changed = append(changed, s.name)
+		}
+	}
+	return changed
+}
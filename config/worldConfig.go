@@ -0,0 +1,16 @@
+package config
+
+import "time"
+
+type WorldConfig struct {
+	// RealmID is ID of realm served by world server.
+	RealmID    uint8  `yaml:"realm_id"`
+	MaxPlayers uint32 `yaml:"max_players"`
+	// Motd is message of the day, lines are separated with `@`.
+	Motd string `yaml:"motd"`
+
+	// HeartbeatInterval is how often world server reports its status to auth server.
+	HeartbeatInterval time.Duration `yaml:"heartbeat_interval"`
+	// HeartbeatTimeout is how long auth server waits for heartbeat before marking realm offline.
+	HeartbeatTimeout time.Duration `yaml:"heartbeat_timeout"`
+}
@@ -17,5 +17,20 @@ func New(c *config.DBConfig) (*DB, error) {
 	if err != nil {
 		return nil, err
 	}
+	registerMetricsCallbacks(db)
 	return &DB{DB: db}, nil
 }
+
+// Transaction runs f in transaction which is committed if f returns nil and rolled back otherwise.
+func (db *DB) Transaction(f func(tx *DB) error) error {
+	tx := db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if err := f(&DB{DB: tx}); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit().Error
+}
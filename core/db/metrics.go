@@ -0,0 +1,44 @@
+package db
+
+import (
+	"github.com/jinzhu/gorm"
+	"time"
+	"xcore/core/metrics"
+)
+
+const metricsStartKey = "metrics:start"
+
+var queryDuration = metrics.NewHistogramVec(
+	"xcore_db_query_duration_seconds",
+	"Duration of database operations.",
+	metrics.DefaultLatencyBuckets,
+	"operation",
+)
+
+// registerMetricsCallbacks measures duration of gorm operations,
+// raw queries run with Exec are not measured.
+func registerMetricsCallbacks(db *gorm.DB) {
+	c := db.Callback()
+	c.Create().Before("gorm:begin_transaction").Register("metrics:before_create", startTimer)
+	c.Create().After("gorm:commit_or_rollback_transaction").Register("metrics:after_create", observeTimer("create"))
+	c.Update().Before("gorm:begin_transaction").Register("metrics:before_update", startTimer)
+	c.Update().After("gorm:commit_or_rollback_transaction").Register("metrics:after_update", observeTimer("update"))
+	c.Delete().Before("gorm:begin_transaction").Register("metrics:before_delete", startTimer)
+	c.Delete().After("gorm:commit_or_rollback_transaction").Register("metrics:after_delete", observeTimer("delete"))
+	c.Query().Before("gorm:query").Register("metrics:before_query", startTimer)
+	c.Query().After("gorm:after_query").Register("metrics:after_query", observeTimer("query"))
+	c.RowQuery().Before("gorm:row_query").Register("metrics:before_row_query", startTimer)
+	c.RowQuery().After("gorm:row_query").Register("metrics:after_row_query", observeTimer("row_query"))
+}
+
+func startTimer(scope *gorm.Scope) {
+	scope.Set(metricsStartKey, time.Now())
+}
+
+func observeTimer(operation string) func(scope *gorm.Scope) {
+	return func(scope *gorm.Scope) {
+		if v, ok := scope.Get(metricsStartKey); ok {
+			queryDuration.ObserveSince(v.(time.Time), operation)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const timeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+type entry struct {
+	time      time.Time
+	level     Level
+	subsystem string
+	msg       string
+	fields    []field
+}
+
+// text formats entry as `<time> <LEVEL> [subsystem] message key=value ...`.
+func (e *entry) text() []byte {
+	var sb strings.Builder
+	sb.WriteString(e.time.Format(timeFormat))
+	sb.WriteByte(' ')
+	sb.WriteString(fmt.Sprintf("%-5s", strings.ToUpper(e.level.String())))
+	if e.subsystem != "" {
+		sb.WriteString(" [" + e.subsystem + "]")
+	}
+	sb.WriteByte(' ')
+	sb.WriteString(strings.TrimRight(e.msg, "\n"))
+	for _, f := range e.fields {
+		sb.WriteString(" " + f.key + "=")
+		v := fmt.Sprint(f.value)
+		if strings.ContainsAny(v, " \"=\n") {
+			v = fmt.Sprintf("%q", v)
+		}
+		sb.WriteString(v)
+	}
+	sb.WriteByte('\n')
+	return []byte(sb.String())
+}
+
+func (e *entry) json() []byte {
+	m := make(map[string]interface{}, len(e.fields)+4)
+	for _, f := range e.fields {
+		switch v := f.value.(type) {
+		case error:
+			m[f.key] = v.Error()
+		case fmt.Stringer:
+			m[f.key] = v.String()
+		default:
+			m[f.key] = v
+		}
+	}
+	m["time"] = e.time.Format(timeFormat)
+	m["level"] = e.level.String()
+	m["msg"] = strings.TrimRight(e.msg, "\n")
+	if e.subsystem != "" {
+		m["subsystem"] = e.subsystem
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		b, _ = json.Marshal(map[string]string{
+			"time":  e.time.Format(timeFormat),
+			"level": LevelError.String(),
+			"msg":   fmt.Sprintf("can not marshal log entry %q: %v", e.msg, err),
+		})
+	}
+	return append(b, '\n')
+}
@@ -0,0 +1,159 @@
+// Package logger implements leveled logger which carries context fields
+// and writes either text or JSON lines.
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+	"xcore/config"
+)
+
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+	Warnf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+
+	// With returns logger which attaches key-value pairs to every line.
+	With(keysAndValues ...interface{}) Logger
+	// Subsystem returns logger of named subsystem with its configured level.
+	Subsystem(name string) Logger
+	Enabled(level Level) bool
+
+	// Reload applies levels and format to all loggers derived from the same root.
+	Reload(c *config.LogConfig) error
+}
+
+type settings struct {
+	format config.LogFormat
+	level  Level
+	levels map[string]Level
+}
+
+// output is shared by all loggers derived from the same root.
+type output struct {
+	mu sync.Mutex
+	w  io.Writer
+
+	settings atomic.Value // *settings
+}
+
+type logger struct {
+	out       *output
+	subsystem string
+	fields    []field
+}
+
+type field struct {
+	key   string
+	value interface{}
+}
+
+func New(c *config.LogConfig) (Logger, error) {
+	return NewWithWriter(c, os.Stderr)
+}
+
+func NewWithWriter(c *config.LogConfig, w io.Writer) (Logger, error) {
+	l := &logger{out: &output{w: w}}
+	if err := l.Reload(c); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *logger) Reload(c *config.LogConfig) error {
+	level, err := ParseLevel(c.Level)
+	if err != nil {
+		return err
+	}
+
+	levels := make(map[string]Level, len(c.Levels))
+	for name, l := range c.Levels {
+		if levels[name], err = ParseLevel(l); err != nil {
+			return fmt.Errorf("subsystem %v: %v", name, err)
+		}
+	}
+
+	switch c.Format {
+	case "", config.LogFormatText, config.LogFormatJSON:
+	default:
+		return fmt.Errorf("unknown log format `%v`", c.Format)
+	}
+
+	l.out.settings.Store(&settings{format: c.Format, level: level, levels: levels})
+	return nil
+}
+
+func (l *logger) Debugf(format string, args ...interface{}) {
+	l.logf(LevelDebug, format, args)
+}
+
+func (l *logger) Infof(format string, args ...interface{}) {
+	l.logf(LevelInfo, format, args)
+}
+
+func (l *logger) Warnf(format string, args ...interface{}) {
+	l.logf(LevelWarn, format, args)
+}
+
+func (l *logger) Errorf(format string, args ...interface{}) {
+	l.logf(LevelError, format, args)
+}
+
+func (l *logger) With(keysAndValues ...interface{}) Logger {
+	fields := make([]field, len(l.fields), len(l.fields)+len(keysAndValues)/2)
+	copy(fields, l.fields)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fields = append(fields, field{key: fmt.Sprint(keysAndValues[i]), value: keysAndValues[i+1]})
+	}
+	return &logger{out: l.out, subsystem: l.subsystem, fields: fields}
+}
+
+func (l *logger) Subsystem(name string) Logger {
+	return &logger{out: l.out, subsystem: name, fields: l.fields}
+}
+
+func (l *logger) Enabled(level Level) bool {
+	return level >= l.settings().levelOf(l.subsystem)
+}
+
+func (l *logger) settings() *settings {
+	return l.out.settings.Load().(*settings)
+}
+
+func (s *settings) levelOf(subsystem string) Level {
+	if level, ok := s.levels[subsystem]; ok {
+		return level
+	}
+	return s.level
+}
+
+func (l *logger) logf(level Level, format string, args []interface{}) {
+	s := l.settings()
+	if level < s.levelOf(l.subsystem) {
+		return
+	}
+
+	e := &entry{
+		time:      time.Now(),
+		level:     level,
+		subsystem: l.subsystem,
+		msg:       fmt.Sprintf(format, args...),
+		fields:    l.fields,
+	}
+
+	var b []byte
+	if s.format == config.LogFormatJSON {
+		b = e.json()
+	} else {
+		b = e.text()
+	}
+
+	l.out.mu.Lock()
+	defer l.out.mu.Unlock()
+	l.out.w.Write(b)
+}
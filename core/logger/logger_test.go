@@ -0,0 +1,183 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"xcore/config"
+)
+
+func newTestLogger(t *testing.T, c *config.LogConfig) (Logger, *bytes.Buffer) {
+	var buf bytes.Buffer
+	l, err := NewWithWriter(c, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l, &buf
+}
+
+func lines(buf *bytes.Buffer) []string {
+	s := strings.TrimRight(buf.String(), "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+func TestLevelFiltering(t *testing.T) {
+	tests := []struct {
+		level string
+		want  []string
+	}{
+		{"debug", []string{"d", "i", "w", "e"}},
+		{"", []string{"i", "w", "e"}},
+		{"warning", []string{"w", "e"}},
+		{"ERROR", []string{"e"}},
+	}
+	for _, tt := range tests {
+		l, buf := newTestLogger(t, &config.LogConfig{Level: tt.level})
+		l.Debugf("d")
+		l.Infof("i")
+		l.Warnf("w")
+		l.Errorf("e")
+
+		var got []string
+		for _, line := range lines(buf) {
+			got = append(got, line[strings.LastIndex(line, " ")+1:])
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("level %q: got %v, want %v", tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestSubsystemLevels(t *testing.T) {
+	l, buf := newTestLogger(t, &config.LogConfig{
+		Level:  "warn",
+		Levels: map[string]string{"packet": "debug", "auth": "error"},
+	})
+
+	l.Infof("root info")
+	l.Subsystem("packet").Debugf("packet debug")
+	l.Subsystem("auth").Warnf("auth warn")
+	l.Subsystem("auth").Errorf("auth error")
+	l.Subsystem("world").Infof("world info")
+	l.Subsystem("world").Warnf("world warn")
+	// override follows subsystem through With
+	l.Subsystem("packet").With("session", 1).Debugf("packet session debug")
+
+	want := []string{"[packet] packet debug", "[auth] auth error", "[world] world warn", "[packet] packet session debug session=1"}
+	got := lines(buf)
+	if len(got) != len(want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	for i := range want {
+		if !strings.HasSuffix(got[i], want[i]) {
+			t.Errorf("line %v: got %q, want suffix %q", i, got[i], want[i])
+		}
+	}
+
+	if !l.Subsystem("packet").Enabled(LevelDebug) || l.Subsystem("auth").Enabled(LevelWarn) {
+		t.Error("Enabled does not follow subsystem levels")
+	}
+}
+
+func TestTextFormat(t *testing.T) {
+	l, buf := newTestLogger(t, &config.LogConfig{Format: config.LogFormatText})
+	l.Subsystem("auth").With("account", "PLAYER", "remote", "1.2.3.4:5", "reason", "two words").Warnf("login %v\n", "failed")
+
+	re := regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{3}\S+ WARN  \[auth\] login failed account=PLAYER remote=1\.2\.3\.4:5 reason="two words"$`)
+	if got := lines(buf); len(got) != 1 || !re.MatchString(got[0]) {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestJSONFormat(t *testing.T) {
+	l, buf := newTestLogger(t, &config.LogConfig{Format: config.LogFormatJSON})
+	// field named level does not override level of entry
+	l.Subsystem("world").With("realm", 1, "err", errors.New("broken"), "level", LevelWarn).Errorf("heartbeat failed")
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("%v: %q", err, buf)
+	}
+	want := map[string]interface{}{
+		"level":     "error",
+		"msg":       "heartbeat failed",
+		"subsystem": "world",
+		"realm":     float64(1),
+		"err":       "broken",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%v: got %v, want %v", k, got[k], v)
+		}
+	}
+	if _, ok := got["time"]; !ok {
+		t.Error("time is missing")
+	}
+}
+
+func TestWithInheritsFields(t *testing.T) {
+	l, buf := newTestLogger(t, &config.LogConfig{})
+	session := l.Subsystem("world").With("session", "s1")
+	account := session.With("account", "PLAYER")
+
+	session.Infof("first")
+	account.Infof("second")
+	// sibling loggers do not share appended fields
+	session.With("other", 2).Infof("third")
+	account.Subsystem("packet").Infof("fourth")
+
+	want := []string{
+		"[world] first session=s1",
+		"[world] second session=s1 account=PLAYER",
+		"[world] third session=s1 other=2",
+		"[packet] fourth session=s1 account=PLAYER",
+	}
+	got := lines(buf)
+	if len(got) != len(want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	for i := range want {
+		if !strings.HasSuffix(got[i], want[i]) {
+			t.Errorf("line %v: got %q, want suffix %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestReloadAppliesToDerivedLoggers(t *testing.T) {
+	l, buf := newTestLogger(t, &config.LogConfig{Level: "error"})
+	derived := l.Subsystem("auth").With("session", "s1")
+
+	derived.Infof("hidden")
+	if err := l.Reload(&config.LogConfig{Level: "info", Format: config.LogFormatJSON}); err != nil {
+		t.Fatal(err)
+	}
+	derived.Infof("shown")
+
+	got := lines(buf)
+	if len(got) != 1 || !strings.Contains(got[0], `"msg":"shown"`) {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestReloadRejectsInvalidConfig(t *testing.T) {
+	l, _ := newTestLogger(t, &config.LogConfig{})
+	invalid := []*config.LogConfig{
+		{Level: "verbose"},
+		{Levels: map[string]string{"auth": "loud"}},
+		{Format: "xml"},
+	}
+	for _, c := range invalid {
+		if err := l.Reload(c); err == nil {
+			t.Errorf("config %+v accepted", c)
+		}
+	}
+	if _, err := NewWithWriter(&config.LogConfig{Level: "verbose"}, &bytes.Buffer{}); err == nil {
+		t.Error("logger created with invalid level")
+	}
+}
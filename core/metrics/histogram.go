@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"bufio"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultLatencyBuckets suit handler and database latencies, in seconds.
+var DefaultLatencyBuckets = []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5}
+
+type histogramValue struct {
+	counts []uint64 // per bucket, not cumulative
+	count  uint64
+	sum    float64
+}
+
+// HistogramVec counts observations in configurable buckets partitioned by labels.
+type HistogramVec struct {
+	metricName string
+	help       string
+	labels     []string
+	buckets    []float64
+
+	mu     sync.Mutex
+	values map[string]*histogramValue
+}
+
+func NewHistogramVec(name string, help string, buckets []float64, labels ...string) *HistogramVec {
+	h := &HistogramVec{
+		metricName: name,
+		help:       help,
+		labels:     labels,
+		buckets:    buckets,
+		values:     map[string]*histogramValue{},
+	}
+	register(h)
+	return h
+}
+
+func (h *HistogramVec) name() string {
+	return h.metricName
+}
+
+func (h *HistogramVec) Observe(v float64, labelValues ...string) {
+	key := labelSet(h.labels, labelValues)
+	i := sort.SearchFloat64s(h.buckets, v)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	hv := h.values[key]
+	if hv == nil {
+		hv = &histogramValue{counts: make([]uint64, len(h.buckets))}
+		h.values[key] = hv
+	}
+	if i < len(h.buckets) {
+		hv.counts[i]++
+	}
+	hv.count++
+	hv.sum += v
+}
+
+// ObserveSince observes seconds passed since start.
+func (h *HistogramVec) ObserveSince(start time.Time, labelValues ...string) {
+	h.Observe(time.Since(start).Seconds(), labelValues...)
+}
+
+func (h *HistogramVec) write(w *bufio.Writer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	writeHeader(w, h.metricName, h.help, "histogram")
+
+	keys := make([]string, 0, len(h.values))
+	for k := range h.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		hv := h.values[k]
+		var cumulative uint64
+		for i, b := range h.buckets {
+			cumulative += hv.counts[i]
+			w.WriteString(h.metricName + "_bucket" + withLabel(k, "le", formatFloat(b)) + " " + formatFloat(float64(cumulative)) + "\n")
+		}
+		w.WriteString(h.metricName + "_bucket" + withLabel(k, "le", "+Inf") + " " + formatFloat(float64(hv.count)) + "\n")
+		w.WriteString(h.metricName + "_sum" + k + " " + formatFloat(hv.sum) + "\n")
+		w.WriteString(h.metricName + "_count" + k + " " + formatFloat(float64(hv.count)) + "\n")
+	}
+}
+
+// withLabel appends label to formatted label set.
+func withLabel(set string, name string, value string) string {
+	pair := name + `="` + value + `"`
+	if set == "" {
+		return "{" + pair + "}"
+	}
+	return strings.TrimSuffix(set, "}") + "," + pair + "}"
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import "testing"
+
+func TestHistogramVecExposition(t *testing.T) {
+	h := NewHistogramVec("test_histogram_seconds", "Latency.", []float64{0.1, 1}, "op")
+	h.Observe(0.05, "read")
+	h.Observe(0.1, "read") // bucket upper bound is inclusive
+	h.Observe(0.5, "read")
+	h.Observe(3, "read")
+
+	want := `# HELP test_histogram_seconds Latency.
+# TYPE test_histogram_seconds histogram
+test_histogram_seconds_bucket{op="read",le="0.1"} 2
+test_histogram_seconds_bucket{op="read",le="1"} 3
+test_histogram_seconds_bucket{op="read",le="+Inf"} 4
+test_histogram_seconds_sum{op="read"} 3.65
+test_histogram_seconds_count{op="read"} 4
+`
+	if got := render(h); got != want {
+		t.Fatalf("got:\n%v\nwant:\n%v", got, want)
+	}
+}
+
+func TestHistogramVecWithoutLabels(t *testing.T) {
+	h := NewHistogramVec("test_histogram_plain_seconds", "Plain.", []float64{1})
+	h.Observe(2)
+
+	want := `# HELP test_histogram_plain_seconds Plain.
+# TYPE test_histogram_plain_seconds histogram
+test_histogram_plain_seconds_bucket{le="1"} 0
+test_histogram_plain_seconds_bucket{le="+Inf"} 1
+test_histogram_plain_seconds_sum 2
+test_histogram_plain_seconds_count 1
+`
+	if got := render(h); got != want {
+		t.Fatalf("got:\n%v\nwant:\n%v", got, want)
+	}
+}
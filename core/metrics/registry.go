@@ -0,0 +1,90 @@
+// Package metrics implements minimal set of Prometheus metric types
+// and exposes them in Prometheus text format.
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type collector interface {
+	name() string
+	write(w *bufio.Writer)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]collector{}
+)
+
+func register(c collector) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[c.name()]; ok {
+		panic("metric " + c.name() + " is already registered")
+	}
+	registry[c.name()] = c
+}
+
+// WriteTo writes all registered metrics to w in Prometheus text format.
+func WriteTo(w io.Writer) error {
+	registryMu.RLock()
+	collectors := make([]collector, 0, len(registry))
+	for _, c := range registry {
+		collectors = append(collectors, c)
+	}
+	registryMu.RUnlock()
+
+	sort.Slice(collectors, func(i, j int) bool {
+		return collectors[i].name() < collectors[j].name()
+	})
+
+	bw := bufio.NewWriter(w)
+	for _, c := range collectors {
+		c.write(bw)
+	}
+	return bw.Flush()
+}
+
+// labelSet formats label pairs as `{a="1",b="2"}`, it is used as key of metric value.
+func labelSet(names []string, values []string) string {
+	if len(names) != len(values) {
+		panic("metric label values do not match label names")
+	}
+	if len(names) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, n := range names {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(n)
+		sb.WriteString(`="`)
+		sb.WriteString(labelEscaper.Replace(values[i]))
+		sb.WriteByte('"')
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func writeHeader(w *bufio.Writer, name string, help string, typ string) {
+	w.WriteString("# HELP " + name + " " + help + "\n")
+	w.WriteString("# TYPE " + name + " " + typ + "\n")
+}
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
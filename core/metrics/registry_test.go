@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// render returns text exposition of single collector.
+func render(c collector) string {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	c.write(w)
+	w.Flush()
+	return buf.String()
+}
+
+func TestWriteToSortsMetricsByName(t *testing.T) {
+	NewCounterVec("test_registry_b_total", "B.").Inc()
+	NewCounterVec("test_registry_a_total", "A.").Inc()
+
+	var buf bytes.Buffer
+	if err := WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+
+	a := strings.Index(out, "# HELP test_registry_a_total")
+	b := strings.Index(out, "# HELP test_registry_b_total")
+	if a < 0 || b < 0 || a > b {
+		t.Fatalf("metrics are missing or not sorted:\n%v", out)
+	}
+}
+
+func TestRegisterDuplicatePanics(t *testing.T) {
+	NewCounterVec("test_registry_duplicate_total", "Duplicate.")
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic on duplicate metric name")
+		}
+	}()
+	NewCounterVec("test_registry_duplicate_total", "Duplicate.")
+}
+
+func TestLabelSetEscapesValues(t *testing.T) {
+	got := labelSet([]string{"a", "b"}, []string{`x"y`, "1\\2\n"})
+	want := `{a="x\"y",b="1\\2\n"}`
+	if got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestLabelSetMismatchPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic on label count mismatch")
+		}
+	}()
+	labelSet([]string{"a"}, nil)
+}
@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"runtime"
+	"time"
+	"xcore/core/logger"
+)
+
+func init() {
+	NewGaugeFunc("go_goroutines", "Number of goroutines that currently exist.", func() float64 {
+		return float64(runtime.NumGoroutine())
+	})
+	NewGaugeFunc("go_memstats_heap_alloc_bytes", "Number of heap bytes allocated and still in use.", func() float64 {
+		var m runtime.MemStats
+		runtime.ReadMemStats(&m)
+		return float64(m.HeapAlloc)
+	})
+}
+
+func Handler(l logger.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		if err := WriteTo(w); err != nil {
+			l.Warnf("can not write metrics: %v", err)
+		}
+	})
+}
+
+const shutdownTimeout = time.Second * 5
+
+// Server exposes metrics over HTTP at `/metrics`.
+type Server interface {
+	Start() error
+	Stop() error
+}
+
+type server struct {
+	logger     logger.Logger
+	httpServer *http.Server
+}
+
+func NewServer(address string, l logger.Logger) Server {
+	l = l.Subsystem("metrics")
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler(l))
+	return &server{
+		logger:     l,
+		httpServer: &http.Server{Addr: address, Handler: mux},
+	}
+}
+
+func (s *server) Start() error {
+	l, err := net.Listen("tcp", s.httpServer.Addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if err := s.httpServer.Serve(l); err != nil && err != http.ErrServerClosed {
+			s.logger.Errorf("metrics server error: %v", err)
+		}
+	}()
+
+	s.logger.Infof("metrics are served at `%v/metrics`", s.httpServer.Addr)
+	return nil
+}
+
+func (s *server) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.httpServer.Shutdown(ctx)
+}
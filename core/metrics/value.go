@@ -0,0 +1,125 @@
+package metrics
+
+import (
+	"bufio"
+	"strconv"
+	"sync"
+)
+
+// valueVec is set of float values partitioned by labels, it backs counters and gauges.
+type valueVec struct {
+	metricName string
+	help       string
+	typ        string
+	labels     []string
+
+	mu     sync.Mutex
+	values map[string]float64
+}
+
+func newValueVec(name string, help string, typ string, labels []string) *valueVec {
+	v := &valueVec{
+		metricName: name,
+		help:       help,
+		typ:        typ,
+		labels:     labels,
+		values:     map[string]float64{},
+	}
+	register(v)
+	return v
+}
+
+func (v *valueVec) name() string {
+	return v.metricName
+}
+
+func (v *valueVec) add(delta float64, labelValues []string) {
+	key := labelSet(v.labels, labelValues)
+	v.mu.Lock()
+	v.values[key] += delta
+	v.mu.Unlock()
+}
+
+func (v *valueVec) set(value float64, labelValues []string) {
+	key := labelSet(v.labels, labelValues)
+	v.mu.Lock()
+	v.values[key] = value
+	v.mu.Unlock()
+}
+
+func (v *valueVec) write(w *bufio.Writer) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	writeHeader(w, v.metricName, v.help, v.typ)
+	for _, k := range sortedKeys(v.values) {
+		w.WriteString(v.metricName + k + " " + formatFloat(v.values[k]) + "\n")
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// CounterVec is monotonically increasing counter partitioned by labels.
+type CounterVec struct {
+	v *valueVec
+}
+
+func NewCounterVec(name string, help string, labels ...string) *CounterVec {
+	return &CounterVec{v: newValueVec(name, help, "counter", labels)}
+}
+
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.v.add(1, labelValues)
+}
+
+func (c *CounterVec) Add(delta float64, labelValues ...string) {
+	if delta < 0 {
+		panic("counter can not decrease")
+	}
+	c.v.add(delta, labelValues)
+}
+
+// GaugeVec is value which can go up and down partitioned by labels.
+type GaugeVec struct {
+	v *valueVec
+}
+
+func NewGaugeVec(name string, help string, labels ...string) *GaugeVec {
+	return &GaugeVec{v: newValueVec(name, help, "gauge", labels)}
+}
+
+func (g *GaugeVec) Inc(labelValues ...string) {
+	g.v.add(1, labelValues)
+}
+
+func (g *GaugeVec) Dec(labelValues ...string) {
+	g.v.add(-1, labelValues)
+}
+
+func (g *GaugeVec) Set(value float64, labelValues ...string) {
+	g.v.set(value, labelValues)
+}
+
+// GaugeFunc is gauge which value is computed on every scrape.
+type GaugeFunc struct {
+	metricName string
+	help       string
+	f          func() float64
+}
+
+func NewGaugeFunc(name string, help string, f func() float64) *GaugeFunc {
+	g := &GaugeFunc{metricName: name, help: help, f: f}
+	register(g)
+	return g
+}
+
+func (g *GaugeFunc) name() string {
+	return g.metricName
+}
+
+func (g *GaugeFunc) write(w *bufio.Writer) {
+	writeHeader(w, g.metricName, g.help, "gauge")
+	w.WriteString(g.metricName + " " + formatFloat(g.f()) + "\n")
+}
@@ -0,0 +1,57 @@
+package metrics
+
+import "testing"
+
+func TestCounterVecExposition(t *testing.T) {
+	c := NewCounterVec("test_value_requests_total", "Requests.", "code", "method")
+	c.Inc("200", "get")
+	c.Add(2.5, "200", "get")
+	c.Inc("500", "post")
+
+	want := `# HELP test_value_requests_total Requests.
+# TYPE test_value_requests_total counter
+test_value_requests_total{code="200",method="get"} 3.5
+test_value_requests_total{code="500",method="post"} 1
+`
+	if got := render(c.v); got != want {
+		t.Fatalf("got:\n%v\nwant:\n%v", got, want)
+	}
+}
+
+func TestCounterVecNegativeAddPanics(t *testing.T) {
+	c := NewCounterVec("test_value_negative_total", "Negative.")
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic on negative delta")
+		}
+	}()
+	c.Add(-1)
+}
+
+func TestGaugeVecExposition(t *testing.T) {
+	g := NewGaugeVec("test_value_sessions", "Sessions.")
+	g.Set(10)
+	g.Inc()
+	g.Dec()
+	g.Dec()
+
+	want := `# HELP test_value_sessions Sessions.
+# TYPE test_value_sessions gauge
+test_value_sessions 9
+`
+	if got := render(g.v); got != want {
+		t.Fatalf("got:\n%v\nwant:\n%v", got, want)
+	}
+}
+
+func TestGaugeFuncExposition(t *testing.T) {
+	g := NewGaugeFunc("test_value_func", "Func.", func() float64 { return 0.25 })
+
+	want := `# HELP test_value_func Func.
+# TYPE test_value_func gauge
+test_value_func 0.25
+`
+	if got := render(g); got != want {
+		t.Fatalf("got:\n%v\nwant:\n%v", got, want)
+	}
+}
@@ -11,4 +11,12 @@ type Account struct {
 	PasswordHash string
 	PasswordKey  sql.NullString
 	SessionKey   sql.NullString
+	TotpSecret   sql.NullString
+	// TotpLastStep is time step of last accepted authenticator code, codes of it and earlier steps are rejected.
+	TotpLastStep uint64
+	GMLevel      uint8
+}
+
+func (a *Account) HasTotpSecret() bool {
+	return a.TotpSecret.Valid && len(a.TotpSecret.String) > 0
 }
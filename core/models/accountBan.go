@@ -0,0 +1,20 @@
+package models
+
+import (
+	"github.com/jinzhu/gorm"
+	"time"
+)
+
+type AccountBan struct {
+	gorm.Model
+	AccountID uint `gorm:"index"`
+	Reason    string
+	Author    string
+	BannedAt  time.Time
+	ExpiresAt *time.Time // nil for permanent ban
+	Active    bool       `gorm:"index"`
+}
+
+func (b *AccountBan) IsPermanent() bool {
+	return b.ExpiresAt == nil
+}
@@ -0,0 +1,27 @@
+package models
+
+import "github.com/jinzhu/gorm"
+
+// Character is player character, its ID is used as low part of player GUID.
+type Character struct {
+	gorm.Model
+	AccountID  uint   `gorm:"index"`
+	RealmID    uint8  `gorm:"unique_index:idx_characters_realm_name"`
+	Name       string `gorm:"size:12;unique_index:idx_characters_realm_name"`
+	Race       uint8
+	Class      uint8
+	Gender     uint8
+	Skin       uint8
+	Face       uint8
+	HairStyle  uint8
+	HairColor  uint8
+	FacialHair uint8
+	Level      uint8
+
+	Map         uint32
+	Zone        uint32
+	X           float32
+	Y           float32
+	Z           float32
+	Orientation float32
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type ClientVersion struct {
+	Major  uint8
+	Minor  uint8
+	BugFix uint8
+	Build  uint16
+}
+
+// ParseClientVersion parses version in `major.minor.bugfix.build` format, e.g. `2.4.3.8606`.
+func ParseClientVersion(s string) (ClientVersion, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 4 {
+		return ClientVersion{}, fmt.Errorf("invalid client version `%v`", s)
+	}
+
+	var nums [3]uint8
+	for i := range nums {
+		n, err := strconv.ParseUint(parts[i], 10, 8)
+		if err != nil {
+			return ClientVersion{}, fmt.Errorf("invalid client version `%v`: %v", s, err)
+		}
+		nums[i] = uint8(n)
+	}
+
+	build, err := strconv.ParseUint(parts[3], 10, 16)
+	if err != nil {
+		return ClientVersion{}, fmt.Errorf("invalid client version `%v`: %v", s, err)
+	}
+
+	return ClientVersion{
+		Major:  nums[0],
+		Minor:  nums[1],
+		BugFix: nums[2],
+		Build:  uint16(build),
+	}, nil
+}
+
+func (v ClientVersion) String() string {
+	return fmt.Sprintf("%v.%v.%v.%v", v.Major, v.Minor, v.BugFix, v.Build)
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"github.com/jinzhu/gorm"
+	"time"
+)
+
+type IPBan struct {
+	gorm.Model
+	Address   string // single address or CIDR range
+	Reason    string
+	Author    string
+	BannedAt  time.Time
+	ExpiresAt *time.Time // nil for permanent ban
+}
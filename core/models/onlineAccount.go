@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// OnlineAccount is account with active world session.
+type OnlineAccount struct {
+	AccountID     uint  `gorm:"primary_key;auto_increment:false"`
+	RealmID       uint8 `gorm:"index"`
+	SessionID     string
+	Since         time.Time
+	KickRequested bool
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Realm struct {
+	ID         uint8 `gorm:"primary_key;auto_increment:false"`
+	Name       string
+	Address    string
+	IsLocked   bool
+	Type       RealmType
+	Flag       RealmFlag
+	Timezone   RealmTimezone
+	Population RealmPopulation
+	// Version is client version the realm runs, its build is always accepted.
+	Version string
+	// AllowedBuilds is comma separated list of other accepted client builds.
+	AllowedBuilds string
+	// HideOnBuildMismatch hides realm from clients with not accepted build
+	// instead of flagging it with RealmFlagVersionMismatch.
+	HideOnBuildMismatch bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	clientVersion ClientVersion
+	builds        []uint16
+}
+
+// Prepare parses and validates version and allowed builds of realm.
+// It has to be called once after realm is loaded.
+func (r *Realm) Prepare() error {
+	v, err := ParseClientVersion(r.Version)
+	if err != nil {
+		return err
+	}
+
+	builds := []uint16{v.Build}
+	for _, b := range strings.Split(r.AllowedBuilds, ",") {
+		b = strings.TrimSpace(b)
+		if b == "" {
+			continue
+		}
+
+		build, err := strconv.ParseUint(b, 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid allowed build `%v` of realm #%v", b, r.ID)
+		}
+		builds = append(builds, uint16(build))
+	}
+
+	r.clientVersion = v
+	r.builds = builds
+	return nil
+}
+
+func (r *Realm) ClientVersion() ClientVersion {
+	return r.clientVersion
+}
+
+func (r *Realm) AcceptsBuild(build uint16) bool {
+	for _, b := range r.builds {
+		if b == build {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,8 @@
+package models
+
+// RealmCharacters is count of characters the account has on the realm.
+type RealmCharacters struct {
+	AccountID uint  `gorm:"primary_key;auto_increment:false"`
+	RealmID   uint8 `gorm:"primary_key;auto_increment:false"`
+	Count     uint8
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// RealmStatus is reported by running world server of the realm.
+type RealmStatus struct {
+	RealmID      uint8 `gorm:"primary_key;auto_increment:false"`
+	Address      string
+	PlayersCount uint32
+	MaxPlayers   uint32
+	StartedAt    time.Time
+	HeartbeatAt  time.Time
+}
+
+func (s *RealmStatus) Population() RealmPopulation {
+	if s.MaxPlayers == 0 {
+		return RealmPopulationLow
+	}
+
+	load := float32(s.PlayersCount) / float32(s.MaxPlayers)
+	switch {
+	case load < 1.0/3:
+		return RealmPopulationLow
+	case load < 2.0/3:
+		return RealmPopulationMedium
+	}
+	return RealmPopulationHigh
+}
+
+func (s *RealmStatus) IsFull() bool {
+	return s.MaxPlayers > 0 && s.PlayersCount >= s.MaxPlayers
+}
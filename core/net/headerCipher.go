@@ -0,0 +1,45 @@
+package net
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+)
+
+var headerCipherSeed = []byte{0x38, 0xA7, 0x83, 0x15, 0xF8, 0x92, 0x25, 0x30, 0x71, 0x98, 0x67, 0xB1, 0x8C, 0x04, 0xE2, 0xAA}
+
+// HeaderCipher encrypts and decrypts world packet headers
+// with a key derived from SRP session key.
+type HeaderCipher struct {
+	key []byte
+
+	sendI, recvI int
+	sendJ, recvJ uint8
+}
+
+func NewHeaderCipher(sessionKey []byte) *HeaderCipher {
+	h := hmac.New(sha1.New, headerCipherSeed)
+	h.Write(sessionKey)
+	return &HeaderCipher{
+		key: h.Sum(nil),
+	}
+}
+
+func (c *HeaderCipher) Encrypt(data []byte) {
+	for i := range data {
+		c.sendI %= len(c.key)
+		x := (data[i] ^ c.key[c.sendI]) + c.sendJ
+		c.sendI++
+		data[i] = x
+		c.sendJ = x
+	}
+}
+
+func (c *HeaderCipher) Decrypt(data []byte) {
+	for i := range data {
+		c.recvI %= len(c.key)
+		x := (data[i] - c.recvJ) ^ c.key[c.recvI]
+		c.recvI++
+		c.recvJ = data[i]
+		data[i] = x
+	}
+}
@@ -0,0 +1,82 @@
+// opcodegen generates name and direction table for net.Opcode constants declared in opcodes.go.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+const (
+	inputFile  = "opcodes.go"
+	outputFile = "opcodeTable.go"
+	countConst = "NUM_MSG_TYPES"
+)
+
+func main() {
+	names, err := parseOpcodeNames(inputFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "// Code generated by opcodegen; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package net")
+	fmt.Fprintln(buf)
+	fmt.Fprintf(buf, "var opcodeTable = [%v]OpcodeInfo{\n", countConst)
+	for _, name := range names {
+		fmt.Fprintf(buf, "\t%v: {Name: %q, Direction: %v},\n", name, name, direction(name))
+	}
+	fmt.Fprintln(buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(outputFile, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseOpcodeNames(path string) ([]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			for _, ident := range spec.(*ast.ValueSpec).Names {
+				if ident.Name != countConst {
+					names = append(names, ident.Name)
+				}
+			}
+		}
+	}
+	return names, nil
+}
+
+func direction(name string) string {
+	switch {
+	case strings.HasPrefix(name, "CMSG_"):
+		return "OpcodeDirectionClient"
+	case strings.HasPrefix(name, "SMSG_"):
+		return "OpcodeDirectionServer"
+	case strings.HasPrefix(name, "MSG_"):
+		return "OpcodeDirectionBoth"
+	}
+	return "OpcodeDirectionUnknown"
+}
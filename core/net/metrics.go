@@ -0,0 +1,18 @@
+package net
+
+import "xcore/core/metrics"
+
+var worldPackets = metrics.NewCounterVec(
+	"xcore_world_packets_total",
+	"World packets received from and sent to clients.",
+	"opcode", "direction",
+)
+
+// opcodeLabel keeps opcode label values bounded by opcode table,
+// clients can send opcodes missing from it.
+func opcodeLabel(o Opcode) string {
+	if !o.Known() {
+		return "unknown"
+	}
+	return o.String()
+}
@@ -0,0 +1,73 @@
+package net
+
+import "fmt"
+
+//go:generate go run ./internal/opcodegen
+
+type OpcodeDirection uint8
+
+const (
+	OpcodeDirectionUnknown OpcodeDirection = iota
+	OpcodeDirectionClient                  // CMSG, sent by client
+	OpcodeDirectionServer                  // SMSG, sent by server
+	OpcodeDirectionBoth                    // MSG, sent by both sides
+)
+
+func (d OpcodeDirection) String() string {
+	switch d {
+	case OpcodeDirectionClient:
+		return "CMSG"
+	case OpcodeDirectionServer:
+		return "SMSG"
+	case OpcodeDirectionBoth:
+		return "MSG"
+	}
+	return "UMSG"
+}
+
+type OpcodeInfo struct {
+	Name        string
+	Direction   OpcodeDirection
+	Implemented bool
+}
+
+func (o Opcode) Info() OpcodeInfo {
+	if o.Known() {
+		return opcodeTable[o]
+	}
+	return OpcodeInfo{
+		Name:      fmt.Sprintf("UNKNOWN_OPCODE_0x%03X", uint16(o)),
+		Direction: OpcodeDirectionUnknown,
+	}
+}
+
+// Known reports whether opcode is present in opcode table.
+func (o Opcode) Known() bool {
+	return o < NUM_MSG_TYPES && opcodeTable[o].Name != ""
+}
+
+func (o Opcode) String() string {
+	return o.Info().Name
+}
+
+// SetOpcodeImplemented marks opcode as having a handler.
+// It is expected to be called during package initialization only.
+func SetOpcodeImplemented(o Opcode) {
+	if o < NUM_MSG_TYPES {
+		opcodeTable[o].Implemented = true
+	}
+}
+
+// ImplementedOpcodesCount returns count of known opcodes with direction d and count of implemented ones.
+func ImplementedOpcodesCount(d OpcodeDirection) (implemented int, total int) {
+	for _, info := range opcodeTable {
+		if info.Name == "" || info.Direction != d {
+			continue
+		}
+		total++
+		if info.Implemented {
+			implemented++
+		}
+	}
+	return implemented, total
+}
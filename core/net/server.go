@@ -1,5 +1,57 @@
 package net
 
+import (
+	"context"
+	"time"
+	"xcore/config"
+)
+
 type Server interface {
-	Run() error
+	Start() error
+	// Stop stops accepting connections and closes active sessions,
+	// it returns ctx error if sessions did not finish before ctx is done.
+	Stop(ctx context.Context) error
+	// Reload applies settings of c which can change at runtime without dropping connections.
+	Reload(c *config.Config) error
+
+	Info() ServerInfo
+	Sessions() []SessionInfo
+	KickSession(id string) bool
+	// KickAccount closes session of account, it reports whether account had one.
+	KickAccount(accountID uint) bool
+	// BroadcastServerMessage sends message to all players in world.
+	BroadcastServerMessage(t ServerMessageType, text string)
+}
+
+type ServerMessageType uint32
+
+const (
+	ServerMessageShutdownTime ServerMessageType = iota + 1
+	ServerMessageRestartTime
+	ServerMessageString
+	ServerMessageShutdownCancelled
+	ServerMessageRestartCancelled
+)
+
+type ServerInfo struct {
+	Name            string
+	Address         string
+	StartedAt       time.Time
+	Sessions        int
+	LoginsPerMinute int
+	Motd            string
+}
+
+func (i ServerInfo) Uptime() time.Duration {
+	if i.StartedAt.IsZero() {
+		return 0
+	}
+	return time.Since(i.StartedAt)
+}
+
+type SessionInfo struct {
+	ID         string
+	RemoteAddr string
+	Account    string
+	Status     string
 }
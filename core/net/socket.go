@@ -2,27 +2,49 @@ package net
 
 import (
 	"encoding/binary"
+	"go.uber.org/atomic"
 	"io"
 	"log"
 	"net"
+	"sync"
 	"time"
 	"xcore/utils"
 )
 
+const defaultReadTimeout = time.Second * 5
+
+// socketConn is part of *net.TCPConn used by Socket.
+type socketConn interface {
+	io.ReadWriteCloser
+	RemoteAddr() net.Addr
+	SetReadDeadline(t time.Time) error
+	SetWriteDeadline(t time.Time) error
+}
+
 type Socket struct {
-	conn     *net.TCPConn
+	conn     socketConn
 	readBuf  *utils.Buffer
 	writeBuf *utils.Buffer
 
-	isClosed bool
+	readTimeout time.Duration
+
+	headerCipher *HeaderCipher
+	worldWriteMu sync.Mutex
+
+	isClosed atomic.Bool
 	onClose  func(err error)
 }
 
 func NewSocket(conn *net.TCPConn) *Socket {
+	return newSocket(conn)
+}
+
+func newSocket(conn socketConn) *Socket {
 	return &Socket{
-		conn:     conn,
-		readBuf:  utils.NewBuffer(),
-		writeBuf: utils.NewBuffer(),
+		conn:        conn,
+		readBuf:     utils.NewBuffer(),
+		writeBuf:    utils.NewBuffer(),
+		readTimeout: defaultReadTimeout,
 	}
 }
 
@@ -30,8 +52,17 @@ func (s *Socket) RemoteAddr() string {
 	return s.conn.RemoteAddr().String()
 }
 
+func (s *Socket) RemoteIP() net.IP {
+	return s.conn.RemoteAddr().(*net.TCPAddr).IP
+}
+
+// SetReadTimeout sets how long ReceiveData waits for incoming data.
+func (s *Socket) SetReadTimeout(d time.Duration) {
+	s.readTimeout = d
+}
+
 func (s *Socket) ReceiveData() error {
-	deadline := time.Now().Add(time.Second * 5)
+	deadline := time.Now().Add(s.readTimeout)
 	if err := s.conn.SetReadDeadline(deadline); err != nil {
 		return err
 	}
@@ -106,6 +137,13 @@ func (s *Socket) MustWriteUInt32(v uint32) *Socket {
 	return s
 }
 
+func (s *Socket) MustWriteUInt64(v uint64) *Socket {
+	if err := s.WriteUInt64(v); err != nil {
+		log.Panic(err)
+	}
+	return s
+}
+
 func (s *Socket) MustWriteFloat32(v float32) *Socket {
 	if err := s.WriteFloat32(v); err != nil {
 		log.Panic(err)
@@ -217,7 +255,7 @@ func (s *Socket) ReadString() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return string(b), nil
+	return string(b[:len(b)-1]), nil
 }
 
 func (s *Socket) MustReadString() string {
@@ -247,43 +285,43 @@ func (s *Socket) MustReadTo(v interface{}) {
 
 func (s *Socket) ReadUInt16() (uint16, error) {
 	var v uint16
-	err := s.ReadTo(v)
+	err := s.ReadTo(&v)
 	return v, err
 }
 
 func (s *Socket) MustReadUInt16() uint16 {
 	var v uint16
-	s.MustReadTo(v)
+	s.MustReadTo(&v)
 	return v
 }
 
 func (s *Socket) ReadUInt32() (uint32, error) {
 	var v uint32
-	err := s.ReadTo(v)
+	err := s.ReadTo(&v)
 	return v, err
 }
 
 func (s *Socket) MustReadUInt32() uint32 {
 	var v uint32
-	s.MustReadTo(v)
+	s.MustReadTo(&v)
 	return v
 }
 
 func (s *Socket) ReadUInt64() (uint64, error) {
 	var v uint64
-	err := s.ReadTo(v)
+	err := s.ReadTo(&v)
 	return v, err
 }
 
 func (s *Socket) MustReadUInt64() uint64 {
 	var v uint64
-	s.MustReadTo(v)
+	s.MustReadTo(&v)
 	return v
 }
 
 func (s *Socket) ReadBool() (bool, error) {
 	var v bool
-	err := s.ReadTo(v)
+	err := s.ReadTo(&v)
 	return v, err
 }
 
@@ -295,25 +333,25 @@ func (s *Socket) MustReadBool() bool {
 
 func (s *Socket) ReadFloat32() (float32, error) {
 	var v float32
-	err := s.ReadTo(v)
+	err := s.ReadTo(&v)
 	return v, err
 }
 
 func (s *Socket) MustReadFloat32() float32 {
 	var v float32
-	s.MustReadTo(v)
+	s.MustReadTo(&v)
 	return v
 }
 
 func (s *Socket) ReadFloat64() (float64, error) {
 	var v float64
-	err := s.ReadTo(v)
+	err := s.ReadTo(&v)
 	return v, err
 }
 
 func (s *Socket) MustReadFloat64() float64 {
 	var v float64
-	s.MustReadTo(v)
+	s.MustReadTo(&v)
 	return v
 }
 
@@ -326,12 +364,11 @@ func (s *Socket) WriteBufferSize() int {
 }
 
 func (s *Socket) Close() error {
-	if s.isClosed {
+	if s.isClosed.Swap(true) {
 		return nil
 	}
 
 	err := s.conn.Close()
-	s.isClosed = true
 
 	if s.onClose != nil {
 		s.onClose(err)
@@ -20,13 +20,13 @@ type asyncTCPListener struct {
 	tcpListener *net.TCPListener
 
 	isClosing atomic.Bool
-	closing   chan bool
+	// done is closed by accept loop when it exits, no callback is called after that
+	done chan struct{}
 }
 
 func newTCPListener(callbacks *tcpListenerCallbacks) tcpListener {
 	listener := new(asyncTCPListener)
 	listener.callbacks = callbacks
-	listener.closing = make(chan bool, 1)
 	return listener
 }
 
@@ -42,6 +42,7 @@ func (listener *asyncTCPListener) listen(address string) error {
 	}
 
 	listener.tcpListener = tcpListener
+	listener.done = make(chan struct{})
 	go listener.startListenLoop()
 	return nil
 }
@@ -50,32 +51,35 @@ func (listener *asyncTCPListener) stop() error {
 	return listener.stopGracefully()
 }
 
+// stopGracefully returns after accept loop exits,
+// so connection accepted meanwhile is either handled or closed before caller goes on.
 func (listener *asyncTCPListener) stopGracefully() error {
-	listener.closing <- true
+	listener.isClosing.Store(true)
 	if err := listener.tcpListener.Close(); err != nil {
 		return err
 	}
-	<-listener.closing
+	<-listener.done
 	listener.tcpListener = nil
 	return nil
 }
 
 func (listener *asyncTCPListener) startListenLoop() {
-loop:
+	defer close(listener.done)
+
 	for {
 		conn, err := listener.tcpListener.AcceptTCP()
 
-		select {
-		case <-listener.closing:
-			break loop
-		default:
-			if err != nil {
-				listener.callbacks.onError(err)
-			} else {
-				listener.callbacks.onConnection(conn)
+		if listener.isClosing.Load() {
+			if conn != nil {
+				conn.Close()
 			}
+			return
 		}
-	}
 
-	listener.closing <- true
+		if err != nil {
+			listener.callbacks.onError(err)
+		} else {
+			listener.callbacks.onConnection(conn)
+		}
+	}
 }
@@ -0,0 +1,130 @@
+package net
+
+import (
+	"errors"
+	"xcore/utils"
+)
+
+const (
+	worldClientHeaderSize = 6
+	worldClientOpcodeSize = 4
+	worldServerHeaderSize = 4
+	worldServerOpcodeSize = 2
+
+	maxWorldClientPacketSize = 10240
+)
+
+var (
+	errMalformedWorldPacket = errors.New("malformed world packet")
+)
+
+type WorldPacket struct {
+	Opcode  Opcode
+	Payload []byte
+}
+
+// WorldPacketReader frames socket stream into world packets.
+// Decrypted header of incomplete packet is kept until the rest of the packet is received.
+type WorldPacketReader struct {
+	sock *Socket
+
+	hasHeader bool
+	size      int
+	opcode    Opcode
+}
+
+func NewWorldPacketReader(sock *Socket) *WorldPacketReader {
+	return &WorldPacketReader{
+		sock: sock,
+	}
+}
+
+// Next returns next packet from already received data or nil if more data is needed.
+func (r *WorldPacketReader) Next() (*WorldPacket, error) {
+	if !r.hasHeader {
+		if r.sock.ReadBufferSize() < worldClientHeaderSize {
+			return nil, nil
+		}
+
+		header, err := r.sock.ReadBytes(worldClientHeaderSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if r.sock.headerCipher != nil {
+			r.sock.headerCipher.Decrypt(header)
+		}
+
+		r.size = int(utils.BigEndian.Uint16(header[:2])) - worldClientOpcodeSize
+		r.opcode = Opcode(utils.LittleEndian.Uint32(header[2:]))
+		if r.size < 0 || r.size > maxWorldClientPacketSize {
+			return nil, errMalformedWorldPacket
+		}
+		r.hasHeader = true
+	}
+
+	if r.sock.ReadBufferSize() < r.size {
+		return nil, nil
+	}
+
+	p := &WorldPacket{
+		Opcode:  r.opcode,
+		Payload: make([]byte, r.size),
+	}
+	if r.size > 0 {
+		b, err := r.sock.ReadBytes(r.size)
+		if err != nil {
+			return nil, err
+		}
+		copy(p.Payload, b)
+	}
+
+	r.hasHeader = false
+	return p, nil
+}
+
+// Read blocks until next packet is received.
+func (r *WorldPacketReader) Read() (*WorldPacket, error) {
+	for {
+		p, err := r.Next()
+		if p != nil {
+			worldPackets.Inc(opcodeLabel(p.Opcode), "in")
+		}
+		if err != nil || p != nil {
+			return p, err
+		}
+
+		if err := r.sock.ReceiveData(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// SetHeaderCipher enables world packet header encryption.
+// Headers of all subsequent world packets are encrypted and decrypted with c.
+func (s *Socket) SetHeaderCipher(c *HeaderCipher) {
+	s.headerCipher = c
+}
+
+// BeginWriteWorldPacket starts server packet with 2-byte big-endian size and 2-byte opcode header.
+// The socket is locked for other writers until CommitWriteWorldPacket is called.
+func (s *Socket) BeginWriteWorldPacket(op Opcode) *Socket {
+	s.worldWriteMu.Lock()
+	return s.BeginWrite().
+		MustWriteUInt16(0). // size is filled on commit
+		MustWriteUInt16(uint16(op))
+}
+
+func (s *Socket) CommitWriteWorldPacket() error {
+	defer s.worldWriteMu.Unlock()
+
+	b := s.writeBuf.Bytes()
+	utils.BigEndian.PutUint16(b, uint16(len(b)-worldServerHeaderSize+worldServerOpcodeSize))
+	worldPackets.Inc(opcodeLabel(Opcode(utils.LittleEndian.Uint16(b[2:]))), "out")
+
+	if s.headerCipher != nil {
+		s.headerCipher.Encrypt(b[:worldServerHeaderSize])
+	}
+
+	return s.CommitWrite()
+}
@@ -0,0 +1,134 @@
+package net
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+// chunkConn returns one chunk per Read, like TCP delivering data in short reads.
+type chunkConn struct {
+	chunks [][]byte
+}
+
+func (c *chunkConn) Read(b []byte) (int, error) {
+	if len(c.chunks) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(b, c.chunks[0])
+	if c.chunks[0] = c.chunks[0][n:]; len(c.chunks[0]) == 0 {
+		c.chunks = c.chunks[1:]
+	}
+	return n, nil
+}
+
+func (c *chunkConn) Write(b []byte) (int, error)        { return len(b), nil }
+func (c *chunkConn) Close() error                       { return nil }
+func (c *chunkConn) RemoteAddr() net.Addr               { return &net.TCPAddr{} }
+func (c *chunkConn) SetReadDeadline(time.Time) error    { return nil }
+func (c *chunkConn) SetWriteDeadline(t time.Time) error { return nil }
+
+// clientHeader returns 2-byte big-endian size and 4-byte little-endian opcode.
+func clientHeader(size int, op Opcode) []byte {
+	return []byte{byte(size >> 8), byte(size), byte(op), byte(op >> 8), 0, 0}
+}
+
+func clientPacket(op Opcode, payload []byte) []byte {
+	return append(clientHeader(len(payload)+worldClientOpcodeSize, op), payload...)
+}
+
+func split(b []byte, at ...int) [][]byte {
+	var chunks [][]byte
+	prev := 0
+	for _, i := range at {
+		chunks = append(chunks, b[prev:i])
+		prev = i
+	}
+	return append(chunks, b[prev:])
+}
+
+func TestWorldPacketReaderFraming(t *testing.T) {
+	ping := clientPacket(CMSG_PING, []byte{1, 0, 0, 0, 2, 0, 0, 0})
+	enum := clientPacket(CMSG_CHAR_ENUM, nil)
+	oversize := clientHeader(maxWorldClientPacketSize+worldClientOpcodeSize+1, CMSG_PING)
+	undersize := clientHeader(worldClientOpcodeSize-2, CMSG_PING)
+
+	tests := []struct {
+		name    string
+		chunks  [][]byte
+		want    []*WorldPacket
+		wantErr error
+	}{
+		{
+			name:   "whole packet",
+			chunks: [][]byte{ping},
+			want:   []*WorldPacket{{CMSG_PING, ping[6:]}},
+		},
+		{
+			name:   "split header",
+			chunks: split(ping, 1, 3, 5),
+			want:   []*WorldPacket{{CMSG_PING, ping[6:]}},
+		},
+		{
+			name:   "split body",
+			chunks: split(ping, 6, 7, 11),
+			want:   []*WorldPacket{{CMSG_PING, ping[6:]}},
+		},
+		{
+			name:   "header and body split together",
+			chunks: split(ping, 4, 9),
+			want:   []*WorldPacket{{CMSG_PING, ping[6:]}},
+		},
+		{
+			name:   "several packets in one read",
+			chunks: [][]byte{append(append(append([]byte{}, enum...), ping...), enum...)},
+			want:   []*WorldPacket{{CMSG_CHAR_ENUM, []byte{}}, {CMSG_PING, ping[6:]}, {CMSG_CHAR_ENUM, []byte{}}},
+		},
+		{
+			name:   "next packet starts in read of previous one",
+			chunks: split(append(append([]byte{}, ping...), ping...), 16),
+			want:   []*WorldPacket{{CMSG_PING, ping[6:]}, {CMSG_PING, ping[6:]}},
+		},
+		{
+			name:    "oversize packet",
+			chunks:  [][]byte{oversize},
+			wantErr: errMalformedWorldPacket,
+		},
+		{
+			name:    "size smaller than opcode",
+			chunks:  [][]byte{undersize},
+			wantErr: errMalformedWorldPacket,
+		},
+		{
+			name:    "connection closed inside packet",
+			chunks:  [][]byte{ping[:10]},
+			wantErr: io.EOF,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewWorldPacketReader(newSocket(&chunkConn{chunks: tt.chunks}))
+
+			for i, want := range tt.want {
+				p, err := r.Read()
+				if err != nil {
+					t.Fatalf("packet %v: unexpected error %v", i, err)
+				}
+				if p.Opcode != want.Opcode || !bytes.Equal(p.Payload, want.Payload) {
+					t.Fatalf("packet %v: got %v %x, want %v %x", i, p.Opcode, p.Payload, want.Opcode, want.Payload)
+				}
+			}
+
+			wantErr := tt.wantErr
+			if wantErr == nil {
+				wantErr = io.EOF
+			}
+			if _, err := r.Read(); err != wantErr {
+				t.Fatalf("got error %v, want %v", err, wantErr)
+			}
+		})
+	}
+}
@@ -253,3 +253,15 @@ func randomBytes(count int) []byte {
 //func copyBigInt(i *big.Int) *big.Int {
 //	return (&(big.Int{})).Set(i)
 //}
+
+const sessionKeySize = 40
+
+// SessionKeyBytes converts session key stored as hex string
+// to little-endian byte array used by world protocol.
+func SessionKeyBytes(h string) []byte {
+	k := utils.ReversedBytes(NewBigIntWithHex(h).Bytes())
+	if len(k) < sessionKeySize {
+		k = append(k, make([]byte, sessionKeySize-len(k))...)
+	}
+	return k
+}
@@ -0,0 +1,87 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	secretSize = 20
+	step       = 30 // seconds
+	digits     = 6
+	// count of steps before and after current one accepted to tolerate clock drift
+	skew = 1
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns new random base32 encoded secret.
+func GenerateSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+// URI returns otpauth URI which can be imported to authenticator apps.
+func URI(issuer string, account string, secret string) string {
+	return fmt.Sprintf("otpauth://totp/%v:%v?secret=%v&issuer=%v&digits=%v&period=%v",
+		issuer, account, secret, issuer, digits, step)
+}
+
+// GenerateCode returns RFC 6238 code for secret at time t.
+func GenerateCode(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return generateCode(key, uint64(t.Unix()/step)), nil
+}
+
+// Validate checks code against secret at time t and returns time step the code was generated for.
+// Callers have to reject steps which were already accepted, code stays valid for the whole skew window.
+func Validate(secret string, code string, t time.Time) (uint64, bool) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+
+	counter := uint64(t.Unix() / step)
+	for i := -skew; i <= skew; i++ {
+		if hmac.Equal([]byte(generateCode(key, counter+uint64(i))), []byte(code)) {
+			return counter + uint64(i), true
+		}
+	}
+	return 0, false
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	s := strings.ToUpper(strings.Replace(secret, " ", "", -1))
+	return encoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+func generateCode(key []byte, counter uint64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+
+	h := hmac.New(sha1.New, key)
+	h.Write(msg)
+	sum := h.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0F
+	value := binary.BigEndian.Uint32(sum[offset:]) & 0x7FFFFFFF
+
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
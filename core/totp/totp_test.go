@@ -0,0 +1,73 @@
+package totp
+
+import (
+	"testing"
+	"time"
+)
+
+// rfcSecret is base32 of ASCII `12345678901234567890`, the SHA1 seed of RFC 6238 appendix B.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestGenerateCodeRFC6238(t *testing.T) {
+	// RFC lists 8-digit codes, 6-digit codes are their last six digits
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},          // 94287082
+		{1111111109, "081804"},  // 07081804
+		{1111111111, "050471"},  // 14050471
+		{1234567890, "005924"},  // 89005924
+		{2000000000, "279037"},  // 69279037
+		{20000000000, "353130"}, // 65353130
+	}
+	for _, tt := range tests {
+		code, err := GenerateCode(rfcSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != tt.code {
+			t.Errorf("T=%v: got %v, want %v", tt.unix, code, tt.code)
+		}
+	}
+}
+
+func TestValidateDriftWindow(t *testing.T) {
+	const generatedStep = 1000
+	generated := time.Unix(generatedStep*step, 0)
+	code, err := GenerateCode(rfcSecret, generated)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		unix  int64
+		valid bool
+	}{
+		{"before previous step", (generatedStep-skew)*step - 1, false},
+		{"first second of previous step", (generatedStep - skew) * step, true},
+		{"same step", generatedStep*step + step/2, true},
+		{"last second of next step", (generatedStep+skew+1)*step - 1, true},
+		{"after next step", (generatedStep + skew + 1) * step, false},
+	}
+	for _, tt := range tests {
+		gotStep, ok := Validate(rfcSecret, code, time.Unix(tt.unix, 0))
+		if ok != tt.valid {
+			t.Errorf("%v: got valid %v, want %v", tt.name, ok, tt.valid)
+		}
+		if ok && gotStep != generatedStep {
+			t.Errorf("%v: got step %v, want %v", tt.name, gotStep, generatedStep)
+		}
+	}
+}
+
+func TestValidateRejectsWrongCodeAndSecret(t *testing.T) {
+	now := time.Unix(59, 0)
+	if _, ok := Validate(rfcSecret, "000000", now); ok {
+		t.Error("wrong code accepted")
+	}
+	if _, ok := Validate("not base32!", "287082", now); ok {
+		t.Error("code accepted with invalid secret")
+	}
+}
@@ -9,4 +9,5 @@ require (
 	github.com/spf13/cobra v0.0.5
 	go.uber.org/atomic v1.4.0
 	gopkg.in/alecthomas/kingpin.v3-unstable v3.0.0-20180810215634-df19058c872c // indirect
+	gopkg.in/yaml.v2 v2.4.0
 )
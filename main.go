@@ -1,51 +1,180 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
+	"os/signal"
+	"syscall"
+	"xcore/api"
 	"xcore/auth"
 	"xcore/cmd"
 	"xcore/config"
+	"xcore/core/db"
+	"xcore/core/logger"
+	"xcore/core/metrics"
+	"xcore/core/net"
 	"xcore/world"
 )
 
 func main() {
 	args := os.Args
 	if len(args) < 2 {
-		log.Println("please specify which server to start [auth | world]")
+		log.Println("please specify which server to start [auth | world | all] or command to run")
 		return
 	}
 
+	c, err := config.Load()
+	if err != nil {
+		log.Fatalf("can not load config: %v", err)
+	}
+	config.SetCurrent(c)
+
+	if args[1] != "auth" && args[1] != "world" && args[1] != "all" {
+		if err := cmd.Execute(args[1:]); err != nil {
+			log.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	l, err := logger.New(c.Log)
+	if err != nil {
+		log.Panic(err)
+	}
+	cmd.SetLogger(l)
+
+	// servers are started in order and stopped in reverse order
+	var servers []net.Server
+	var shared *auth.Shared
 	switch args[1] {
 	case "auth":
-		go runAuthServer()
+		servers = append(servers, mustServer(auth.NewServer(c, l, nil)))
 	case "world":
-		go runWorldServer()
-	default:
-		log.Println("please specify server type to run [auth | world]")
-		return
+		servers = append(servers, mustServer(world.NewServer(c, l, nil)))
+	case "all":
+		xdb, err := db.New(c.DBConfig)
+		if err != nil {
+			log.Panic(err)
+		}
+		defer xdb.Close()
+
+		// one database pool and key store serve servers, api and console commands
+		shared = &auth.Shared{DB: xdb, Keys: auth.NewSessionKeyStore(), Kicks: make(chan struct{}, 1)}
+		cmd.SetShared(shared)
+		servers = append(servers, mustServer(auth.NewServer(c, l, shared)))
+		servers = append(servers, mustServer(world.NewServer(c, l, shared)))
 	}
 
-	cmd.StartCLI()
-}
+	for _, s := range servers {
+		cmd.AddServer(s)
+		if err := s.Start(); err != nil {
+			log.Panic(err)
+		}
+	}
 
-func runAuthServer() {
-	s, err := auth.NewServer(config.Current())
-	if err != nil {
-		log.Panic(err)
+	var rc cmd.RemoteConsole
+	if c.AdminConsole.Enabled {
+		rc, err = cmd.NewRemoteConsole(c, l)
+		if err != nil {
+			log.Panic(err)
+		}
+		if err := rc.Start(); err != nil {
+			log.Panic(err)
+		}
 	}
-	if err := s.Run(); err != nil {
-		log.Panic(err)
+
+	var ms metrics.Server
+	if c.Metrics.Enabled {
+		ms = metrics.NewServer(c.Metrics.Address, l)
+		if err := ms.Start(); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	var as api.Server
+	if c.HTTPAPI.Enabled {
+		as, err = api.NewServer(c, l, shared)
+		if err != nil {
+			log.Panic(err)
+		}
+		if err := as.Start(); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	go reloadOnHangup(l)
+	go cmd.StartCLI()
+
+	waitExit(l)
+
+	// all servers share one hard deadline
+	ctx, cancel := context.WithTimeout(context.Background(), config.Current().ShutdownTimeout)
+	defer cancel()
+	if as != nil {
+		if err := as.Stop(); err != nil {
+			l.Errorf("can not stop http api: %v", err)
+		}
+	}
+	if rc != nil {
+		if err := rc.Stop(); err != nil {
+			l.Errorf("can not stop remote console: %v", err)
+		}
+	}
+	for i := len(servers) - 1; i >= 0; i-- {
+		if err := servers[i].Stop(ctx); err != nil {
+			l.Errorf("can not stop %v server: %v", servers[i].Info().Name, err)
+		}
+	}
+
+	// metrics are served until the end, so drain can be observed
+	if ms != nil {
+		if err := ms.Stop(); err != nil {
+			l.Errorf("can not stop metrics server: %v", err)
+		}
 	}
 }
 
-func runWorldServer() {
-	s, err := world.NewServer(config.Current())
-	if err != nil {
-		log.Panic(err)
+// waitExit blocks until shutdown countdown ends. Countdown is started from console or by exit signal,
+// players are notified the same way in both cases. Second signal skips countdown.
+func waitExit(l logger.Logger) {
+	c := make(chan os.Signal, 2)
+	signal.Notify(c, syscall.SIGTERM, syscall.SIGINT)
+
+	signaled := false
+	for {
+		select {
+		case <-c:
+			grace := config.Current().ShutdownGracePeriod
+			if signaled || grace == 0 {
+				return
+			}
+			signaled = true
+
+			if err := cmd.ScheduleShutdown(grace); err != nil {
+				l.Warnf("can not schedule shutdown: %v", err)
+				continue
+			}
+			l.Infof("shutdown in %v, send signal again to stop now", grace)
+		case <-cmd.ShutdownRequested():
+			return
+		}
 	}
+}
 
-	if err := s.Run(); err != nil {
+func reloadOnHangup(l logger.Logger) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+	for range c {
+		if _, err := cmd.Reload(); err != nil {
+			l.Errorf("can not reload config: %v", err)
+		}
+	}
+}
+
+func mustServer(s net.Server, err error) net.Server {
+	if err != nil {
 		log.Panic(err)
 	}
+	return s
 }
@@ -25,7 +25,7 @@ func (o *ByteOrder) UInt32ToBytes(v uint32) []byte {
 }
 
 func (o *ByteOrder) UInt64ToBytes(v uint64) []byte {
-	buf := [4]byte{}
+	buf := [8]byte{}
 	o.PutUint64(buf[:], v)
 	return buf[:]
 }
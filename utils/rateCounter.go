@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// RateCounter counts events happened during last window of time.
+type RateCounter struct {
+	mu     sync.Mutex
+	window time.Duration
+	events []time.Time
+}
+
+func NewRateCounter(window time.Duration) *RateCounter {
+	return &RateCounter{window: window}
+}
+
+func (c *RateCounter) Add() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	c.events = append(c.unexpired(now), now)
+}
+
+func (c *RateCounter) Count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.events = c.unexpired(time.Now())
+	return len(c.events)
+}
+
+func (c *RateCounter) unexpired(now time.Time) []time.Time {
+	i := 0
+	for i < len(c.events) && now.Sub(c.events[i]) > c.window {
+		i++
+	}
+	return c.events[i:]
+}
@@ -0,0 +1,28 @@
+package world
+
+type authResult uint8
+
+const (
+	authResultOK                  authResult = 0x0C
+	authResultFailed              authResult = 0x0D
+	authResultReject              authResult = 0x0E
+	authResultBadServerProof      authResult = 0x0F
+	authResultUnavailable         authResult = 0x10
+	authResultSystemError         authResult = 0x11
+	authResultBillingError        authResult = 0x12
+	authResultBillingExpired      authResult = 0x13
+	authResultVersionMismatch     authResult = 0x14
+	authResultUnknownAccount      authResult = 0x15
+	authResultIncorrectPassword   authResult = 0x16
+	authResultSessionExpired      authResult = 0x17
+	authResultServerShuttingDown  authResult = 0x18
+	authResultAlreadyLoggingIn    authResult = 0x19
+	authResultLoginServerNotFound authResult = 0x1A
+	authResultWaitQueue           authResult = 0x1B
+	authResultBanned              authResult = 0x1C
+	authResultAlreadyOnline       authResult = 0x1D
+	authResultNoTime              authResult = 0x1E
+	authResultDBBusy              authResult = 0x1F
+	authResultSuspended           authResult = 0x20
+	authResultParentalControl     authResult = 0x21
+)
@@ -0,0 +1,40 @@
+package world
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+type authSession struct {
+	build         uint32
+	loginServerID uint32
+	accountName   string
+	clientSeed    uint32
+	digest        [20]uint8
+}
+
+func newAuthSession(b []byte) (*authSession, error) {
+	buf := bytes.NewBuffer(b)
+	p := new(authSession)
+
+	if err := binary.Read(buf, binary.LittleEndian, &p.build); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(buf, binary.LittleEndian, &p.loginServerID); err != nil {
+		return nil, err
+	}
+	name, err := buf.ReadBytes(0)
+	if err != nil {
+		return nil, err
+	}
+	p.accountName = string(name[:len(name)-1])
+	if err := binary.Read(buf, binary.LittleEndian, &p.clientSeed); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(buf, binary.LittleEndian, &p.digest); err != nil {
+		return nil, err
+	}
+
+	// the rest of the packet is addon info which is not used yet
+	return p, nil
+}
@@ -0,0 +1,38 @@
+package world
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+type charCreate struct {
+	name       string
+	race       uint8
+	class      uint8
+	gender     uint8
+	skin       uint8
+	face       uint8
+	hairStyle  uint8
+	hairColor  uint8
+	facialHair uint8
+	outfitID   uint8
+}
+
+func newCharCreate(b []byte) (*charCreate, error) {
+	buf := bytes.NewBuffer(b)
+	p := new(charCreate)
+
+	name, err := buf.ReadBytes(0)
+	if err != nil {
+		return nil, err
+	}
+	p.name = string(name[:len(name)-1])
+
+	fields := []*uint8{&p.race, &p.class, &p.gender, &p.skin, &p.face, &p.hairStyle, &p.hairColor, &p.facialHair, &p.outfitID}
+	for _, f := range fields {
+		if err := binary.Read(buf, binary.LittleEndian, f); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
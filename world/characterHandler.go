@@ -0,0 +1,196 @@
+package world
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"unicode"
+	"xcore/core/models"
+	"xcore/core/net"
+)
+
+const (
+	maxCharactersPerRealm = 10
+	minCharacterNameLen   = 2
+	maxCharacterNameLen   = 12
+
+	// equipment slots and the first bag slot are listed in SMSG_CHAR_ENUM
+	charEnumEquipmentSlots = 20
+)
+
+type startPosition struct {
+	mapID   uint32
+	zone    uint32
+	x, y, z float32
+}
+
+// startPositions of races available in 2.4.3.
+var startPositions = map[uint8]startPosition{
+	1:  {0, 12, -8949.95, -132.493, 83.5312},     // human
+	2:  {1, 14, -618.518, -4251.67, 38.718},      // orc
+	3:  {0, 1, -6240.32, 331.033, 382.758},       // dwarf
+	4:  {1, 141, 10311.3, 832.463, 1326.41},      // night elf
+	5:  {0, 85, 1676.71, 1678.31, 121.67},        // undead
+	6:  {1, 215, -2917.58, -257.98, 52.9968},     // tauren
+	7:  {0, 1, -6240.32, 331.033, 382.758},       // gnome
+	8:  {1, 14, -618.518, -4251.67, 38.718},      // troll
+	10: {530, 3431, 10349.6, -6357.29, 33.4026},  // blood elf
+	11: {530, 3526, -3961.64, -13931.2, 100.615}, // draenei
+}
+
+func (s *session) handleCharEnumOpcode(p *net.WorldPacket) error {
+	chars, err := s.srv.charRepo.GetCharacters(s.account.ID, s.srv.config.World.RealmID)
+	if err != nil {
+		return err
+	}
+
+	w := s.sock.BeginWriteWorldPacket(net.SMSG_CHAR_ENUM).
+		MustWriteByte(byte(len(chars)))
+
+	for _, c := range chars {
+		w.MustWriteUInt64(uint64(c.ID)).
+			MustWriteBytes([]byte(c.Name)).
+			MustWriteByte(0).
+			MustWriteByte(c.Race).
+			MustWriteByte(c.Class).
+			MustWriteByte(c.Gender).
+			MustWriteByte(c.Skin).
+			MustWriteByte(c.Face).
+			MustWriteByte(c.HairStyle).
+			MustWriteByte(c.HairColor).
+			MustWriteByte(c.FacialHair).
+			MustWriteByte(c.Level).
+			MustWriteUInt32(c.Zone).
+			MustWriteUInt32(c.Map).
+			MustWriteFloat32(c.X).
+			MustWriteFloat32(c.Y).
+			MustWriteFloat32(c.Z).
+			MustWriteUInt32(0). // guild id
+			MustWriteUInt32(0). // character flags
+			MustWriteByte(0).   // first login
+			MustWriteUInt32(0). // pet display id
+			MustWriteUInt32(0). // pet level
+			MustWriteUInt32(0)  // pet family
+
+		// items are not stored yet, all slots are empty
+		for i := 0; i < charEnumEquipmentSlots; i++ {
+			// display id, inventory type, enchant aura id
+			w.MustWriteUInt32(0).MustWriteByte(0).MustWriteUInt32(0)
+		}
+	}
+
+	return s.sock.CommitWriteWorldPacket()
+}
+
+func (s *session) handleCharCreateOpcode(p *net.WorldPacket) error {
+	payload, err := newCharCreate(p.Payload)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.createCharacter(payload)
+	if err != nil {
+		return err
+	}
+	return s.sendCharacterResult(net.SMSG_CHAR_CREATE, res)
+}
+
+func (s *session) createCharacter(p *charCreate) (characterResult, error) {
+	realmID := s.srv.config.World.RealmID
+
+	name, ok := normalizeCharacterName(p.name)
+	if !ok {
+		return charCreateError, nil
+	}
+	pos, ok := startPositions[p.race]
+	if !ok || !isValidClass(p.class) || p.gender > 1 {
+		return charCreateFailed, nil
+	}
+
+	count, err := s.srv.charRepo.CountCharacters(s.account.ID, realmID)
+	if err != nil {
+		return 0, err
+	}
+	if count >= maxCharactersPerRealm {
+		return charCreateServerLimit, nil
+	}
+
+	exists, err := s.srv.charRepo.HasCharacterWithName(name, realmID)
+	if err != nil {
+		return 0, err
+	}
+	if exists {
+		return charCreateNameInUse, nil
+	}
+
+	c := &models.Character{
+		AccountID:  s.account.ID,
+		RealmID:    realmID,
+		Name:       name,
+		Race:       p.race,
+		Class:      p.class,
+		Gender:     p.gender,
+		Skin:       p.skin,
+		Face:       p.face,
+		HairStyle:  p.hairStyle,
+		HairColor:  p.hairColor,
+		FacialHair: p.facialHair,
+		Level:      1,
+		Map:        pos.mapID,
+		Zone:       pos.zone,
+		X:          pos.x,
+		Y:          pos.y,
+		Z:          pos.z,
+	}
+	if err := s.srv.charRepo.CreateCharacter(c); err != nil {
+		return 0, err
+	}
+
+	s.logger.Infof("character %v created", name)
+	return charCreateSuccess, nil
+}
+
+func (s *session) handleCharDeleteOpcode(p *net.WorldPacket) error {
+	var guid uint64
+	if err := binary.Read(bytes.NewBuffer(p.Payload), binary.LittleEndian, &guid); err != nil {
+		return err
+	}
+
+	deleted, err := s.srv.charRepo.DeleteCharacter(s.account.ID, s.srv.config.World.RealmID, uint(guid))
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return s.sendCharacterResult(net.SMSG_CHAR_DELETE, charDeleteFailed)
+	}
+
+	s.logger.Infof("character %v deleted", guid)
+	return s.sendCharacterResult(net.SMSG_CHAR_DELETE, charDeleteSuccess)
+}
+
+func (s *session) sendCharacterResult(op net.Opcode, res characterResult) error {
+	s.sock.BeginWriteWorldPacket(op).
+		MustWriteByte(byte(res))
+
+	return s.sock.CommitWriteWorldPacket()
+}
+
+// normalizeCharacterName checks name contains only letters and capitalizes it.
+func normalizeCharacterName(name string) (string, bool) {
+	runes := []rune(strings.ToLower(name))
+	if len(runes) < minCharacterNameLen || len(runes) > maxCharacterNameLen {
+		return "", false
+	}
+	for _, r := range runes {
+		if !unicode.IsLetter(r) {
+			return "", false
+		}
+	}
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes), true
+}
+
+// isValidClass reports whether class exists in 2.4.3, death knight and class 10 do not.
+func isValidClass(class uint8) bool {
+	return class >= 1 && class <= 11 && class != 6 && class != 10
+}
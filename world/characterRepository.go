@@ -0,0 +1,88 @@
+package world
+
+import (
+	"github.com/jinzhu/gorm"
+	"xcore/auth"
+	"xcore/core/db"
+	"xcore/core/models"
+)
+
+type CharacterRepository interface {
+	GetCharacters(accountID uint, realmID uint8) ([]*models.Character, error)
+	CountCharacters(accountID uint, realmID uint8) (int, error)
+	HasCharacterWithName(name string, realmID uint8) (bool, error)
+	// CreateCharacter creates character and updates characters count shown in realm list in one transaction.
+	CreateCharacter(c *models.Character) error
+	// DeleteCharacter removes character of account and updates characters count in one transaction,
+	// false is returned if account has no such character.
+	DeleteCharacter(accountID uint, realmID uint8, id uint) (bool, error)
+}
+
+type characterRepository struct {
+	db *db.DB
+}
+
+func NewCharacterRepository(db *db.DB) (CharacterRepository, error) {
+	r := &characterRepository{
+		db: db,
+	}
+	if err := r.migrate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *characterRepository) migrate() error {
+	return r.db.AutoMigrate(&models.Character{}).Error
+}
+
+func (r *characterRepository) GetCharacters(accountID uint, realmID uint8) ([]*models.Character, error) {
+	var chars []*models.Character
+	err := r.db.Where("account_id = ? AND realm_id = ?", accountID, realmID).
+		Order("id").
+		Find(&chars).Error
+	return chars, err
+}
+
+func (r *characterRepository) CountCharacters(accountID uint, realmID uint8) (int, error) {
+	var count int
+	err := r.db.Model(&models.Character{}).
+		Where("account_id = ? AND realm_id = ?", accountID, realmID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *characterRepository) HasCharacterWithName(name string, realmID uint8) (bool, error) {
+	var c models.Character
+	err := r.db.Unscoped().Where("realm_id = ? AND name = ?", realmID, name).First(&c).Error
+	if err == gorm.ErrRecordNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (r *characterRepository) CreateCharacter(c *models.Character) error {
+	return r.db.Transaction(func(tx *db.DB) error {
+		if err := tx.Create(c).Error; err != nil {
+			return err
+		}
+		return auth.AddCharacterCount(tx, c.AccountID, c.RealmID, 1)
+	})
+}
+
+func (r *characterRepository) DeleteCharacter(accountID uint, realmID uint8, id uint) (bool, error) {
+	deleted := false
+	err := r.db.Transaction(func(tx *db.DB) error {
+		// deleted characters are removed completely, so their names are free again
+		res := tx.Unscoped().
+			Where("id = ? AND account_id = ? AND realm_id = ?", id, accountID, realmID).
+			Delete(&models.Character{})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+
+		deleted = true
+		return auth.AddCharacterCount(tx, accountID, realmID, -1)
+	})
+	return deleted && err == nil, err
+}
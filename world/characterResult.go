@@ -0,0 +1,14 @@
+package world
+
+type characterResult uint8
+
+const (
+	charCreateSuccess     characterResult = 0x2E
+	charCreateError       characterResult = 0x2F
+	charCreateFailed      characterResult = 0x30
+	charCreateNameInUse   characterResult = 0x31
+	charCreateServerLimit characterResult = 0x34
+
+	charDeleteSuccess characterResult = 0x3A
+	charDeleteFailed  characterResult = 0x3B
+)
@@ -0,0 +1,20 @@
+package world
+
+import "xcore/core/metrics"
+
+var (
+	connectionsAccepted = metrics.NewCounterVec(
+		"xcore_world_connections_accepted_total",
+		"Connections accepted by world server.",
+	)
+	sessionsActive = metrics.NewGaugeVec(
+		"xcore_world_sessions_active",
+		"Authorized world sessions.",
+	)
+	handlerDuration = metrics.NewHistogramVec(
+		"xcore_world_handler_duration_seconds",
+		"Duration of world opcode handlers.",
+		metrics.DefaultLatencyBuckets,
+		"opcode",
+	)
+)
@@ -0,0 +1,26 @@
+package world
+
+import (
+	"bytes"
+	"encoding/binary"
+	"xcore/core/net"
+)
+
+func (s *session) handlePingOpcode(p *net.WorldPacket) error {
+	var ping, latency uint32
+
+	buf := bytes.NewBuffer(p.Payload)
+	if err := binary.Read(buf, binary.LittleEndian, &ping); err != nil {
+		return err
+	}
+	if err := binary.Read(buf, binary.LittleEndian, &latency); err != nil {
+		return err
+	}
+
+	s.latency = latency
+
+	s.sock.BeginWriteWorldPacket(net.SMSG_PONG).
+		MustWriteUInt32(ping)
+
+	return s.sock.CommitWriteWorldPacket()
+}
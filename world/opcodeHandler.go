@@ -0,0 +1,48 @@
+package world
+
+import (
+	"sync"
+	"xcore/core/net"
+)
+
+// opcodeHandler runs in session receive goroutine as soon as packet is received.
+type opcodeHandler struct {
+	status  sessionStatus
+	handler func(s *session, p *net.WorldPacket) error
+}
+
+var opcodeHandlers map[net.Opcode]*opcodeHandler
+var reportedOpcodes sync.Map
+
+func init() {
+	opcodeHandlers = map[net.Opcode]*opcodeHandler{
+		net.CMSG_CHAR_ENUM: {
+			status:  authedStatus,
+			handler: (*session).handleCharEnumOpcode,
+		},
+		net.CMSG_CHAR_CREATE: {
+			status:  authedStatus,
+			handler: (*session).handleCharCreateOpcode,
+		},
+		net.CMSG_CHAR_DELETE: {
+			status:  authedStatus,
+			handler: (*session).handleCharDeleteOpcode,
+		},
+		net.CMSG_PING: {
+			status:  anyAuthedStatus,
+			handler: (*session).handlePingOpcode,
+		},
+	}
+
+	for op := range opcodeHandlers {
+		net.SetOpcodeImplemented(op)
+	}
+}
+
+// reportOpcode logs problem with opcode only the first time it happens.
+func (s *session) reportOpcode(op net.Opcode, reason string) {
+	if _, reported := reportedOpcodes.LoadOrStore(op, true); reported {
+		return
+	}
+	s.logger.Warnf("received %v opcode %v (0x%03X), dropping", reason, op, uint16(op))
+}
@@ -1,75 +1,275 @@
 package world
 
 import (
-	"log"
+	"context"
+	uuid "github.com/satori/go.uuid"
+	"go.uber.org/atomic"
 	xnet "net"
-	"os"
-	"os/signal"
-	"syscall"
+	"sync"
+	"time"
+	"xcore/auth"
 	"xcore/config"
 	"xcore/core/db"
+	"xcore/core/logger"
+	"xcore/core/models"
 	"xcore/core/net"
+	"xcore/utils"
 )
 
 type server struct {
-	config    *config.Config
-	db        *db.DB
+	config *config.Config
+	logger logger.Logger
+	// packetLogger logs every received packet at debug level
+	packetLogger logger.Logger
+
+	db         *db.DB
+	accRepo    auth.AccountRepository
+	statusRepo auth.RealmStatusRepository
+	charRepo   CharacterRepository
+	onlineRepo auth.OnlineAccountRepository
+	// keys is nil unless auth server runs in the same process
+	keys auth.SessionKeyStore
+	// kicks is signaled by auth server and http api of the same process, nil for standalone server
+	kicks <-chan struct{}
+
 	tcpServer net.TCPServer
+	sessions  *sessionManager
+	// sessionsWG tracks goroutines of accepted connections
+	sessionsWG sync.WaitGroup
+	logins     *utils.RateCounter
+	startedAt  time.Time
+
+	motd atomic.String
+
+	stopping chan struct{}
 }
 
-func NewServer(c *config.Config) (net.Server, error) {
-	xdb, err := db.New(c.DBConfig)
+// NewServer creates world server, shared may be nil when server runs standalone.
+func NewServer(c *config.Config, l logger.Logger, shared *auth.Shared) (net.Server, error) {
+	var xdb *db.DB
+	var keys auth.SessionKeyStore
+	var kicks chan struct{}
+	var err error
+	if shared != nil {
+		xdb, keys, kicks = shared.DB, shared.Keys, shared.Kicks
+	} else if xdb, err = db.New(c.DBConfig); err != nil {
+		return nil, err
+	}
+
+	accRepo, err := auth.NewAccountRepository(c, xdb, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	statusRepo, err := auth.NewRealmStatusRepository(xdb)
+	if err != nil {
+		return nil, err
+	}
+
+	// counts table is updated by character repository
+	if _, err := auth.NewCharacterCountRepository(xdb); err != nil {
+		return nil, err
+	}
+
+	onlineRepo, err := auth.NewOnlineAccountRepository(xdb, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	charRepo, err := NewCharacterRepository(xdb)
 	if err != nil {
 		return nil, err
 	}
 
 	s := new(server)
 	s.config = c
+	s.logger = l.Subsystem("world").With("realm", c.World.RealmID)
+	s.packetLogger = l.Subsystem("packet").With("realm", c.World.RealmID)
 	s.db = xdb
+	s.accRepo = accRepo
+	s.statusRepo = statusRepo
+	s.onlineRepo = onlineRepo
+	s.charRepo = charRepo
+	s.keys = keys
+	s.kicks = kicks
+	s.sessions = newSessionManager()
+	s.logins = utils.NewRateCounter(time.Minute)
+	s.motd.Store(c.World.Motd)
+	s.stopping = make(chan struct{})
 	s.tcpServer = net.NewTCPServer(&net.ServerParameters{
 		OnConnection: s.handleConnection,
 		OnError: func(err error) {
-			println(err)
+			s.logger.Errorf("world listener error: %v", err)
 		},
 	})
 
 	return s, nil
 }
 
-func (srv *server) start() error {
+func (srv *server) Start() error {
 	if err := srv.tcpServer.Start(srv.config.WorldServerAddress); err != nil {
 		return err
 	}
 
-	log.Printf("World server started, listening `%s`\n", srv.config.WorldServerAddress)
+	wc := srv.config.World
+	if err := srv.onlineRepo.ClearRealm(wc.RealmID); err != nil {
+		return err
+	}
+	if err := srv.statusRepo.RegisterRealm(wc.RealmID, srv.config.WorldServerAddress, wc.MaxPlayers); err != nil {
+		return err
+	}
+
+	srv.startedAt = time.Now()
+	go srv.runHeartbeatLoop()
+
+	srv.logger.Infof("world server of realm #%v started, listening `%s`", wc.RealmID, srv.config.WorldServerAddress)
+
+	implemented, total := net.ImplementedOpcodesCount(net.OpcodeDirectionClient)
+	srv.logger.Infof("%v of %v client opcodes are implemented", implemented, total)
 	return nil
 }
 
-func (srv *server) stop() error {
+func (srv *server) Stop(ctx context.Context) error {
 	if err := srv.tcpServer.Stop(); err != nil {
 		return err
 	}
 
-	log.Println("World server stopped")
-	return nil
+	// sessions mark their accounts offline when finished
+	srv.sessions.closeAll()
+	waitErr := utils.WaitContext(ctx, &srv.sessionsWG)
+	if waitErr != nil {
+		srv.logger.Warnf("world sessions did not finish before deadline: %v", waitErr)
+	}
+	close(srv.stopping)
+
+	if err := srv.statusRepo.UnregisterRealm(srv.config.World.RealmID); err != nil {
+		return err
+	}
+	if err := srv.onlineRepo.ClearRealm(srv.config.World.RealmID); err != nil {
+		return err
+	}
+
+	srv.logger.Infof("world server stopped")
+	return waitErr
 }
 
-func (srv *server) Run() error {
-	if err := srv.start(); err != nil {
+func (srv *server) Reload(c *config.Config) error {
+	srv.motd.Store(c.World.Motd)
+	if err := srv.accRepo.CreateDevAccounts(c.DevAccounts); err != nil {
 		return err
 	}
-	srv.waitExitSignal()
-	return srv.stop()
+
+	srv.logger.Infof("world server reloaded")
+	return nil
+}
+
+func (srv *server) Info() net.ServerInfo {
+	return net.ServerInfo{
+		Name:            "world",
+		Address:         srv.config.WorldServerAddress,
+		StartedAt:       srv.startedAt,
+		Sessions:        srv.sessions.count(),
+		LoginsPerMinute: srv.logins.Count(),
+		Motd:            srv.motd.Load(),
+	}
+}
+
+func (srv *server) Sessions() []net.SessionInfo {
+	var sessions []net.SessionInfo
+	srv.sessions.forEach(func(s *session) {
+		sessions = append(sessions, s.info())
+	})
+	return sessions
+}
+
+func (srv *server) KickSession(id string) bool {
+	s := srv.sessions.find(id)
+	if s == nil {
+		return false
+	}
+	s.getLogger().Infof("world session kicked from console")
+	s.close()
+	return true
+}
+
+func (srv *server) KickAccount(accountID uint) bool {
+	s := srv.sessions.findByAccount(accountID)
+	if s == nil {
+		return false
+	}
+	s.getLogger().Infof("world session of account kicked")
+	s.close()
+	return true
+}
+
+// getAccount returns account with session key from in-process key store when auth server runs in the same process,
+// database is read only by standalone world server.
+func (srv *server) getAccount(name string) (*models.Account, error) {
+	if srv.keys != nil {
+		return srv.keys.Get(name), nil
+	}
+	return srv.accRepo.GetAccountWithName(name)
 }
 
 func (srv *server) handleConnection(conn *xnet.TCPConn) {
-	s := NewSession(conn)
-	go s.start()
+	connectionsAccepted.Inc()
+	id := uuid.NewV4().String()
+	s := newSession(id, conn, srv)
+
+	srv.sessionsWG.Add(1)
+	go func() {
+		defer srv.sessionsWG.Done()
+		s.start()
+	}()
+}
+
+func (srv *server) BroadcastServerMessage(t net.ServerMessageType, text string) {
+	srv.sessions.forEach(func(s *session) {
+		// characters can not enter world yet, players at character screen are notified as well
+		if !anyAuthedStatus.has(s.getStatus()) {
+			return
+		}
+		if err := s.sendServerMessage(t, text); err != nil {
+			s.getLogger().Warnf("can not send server message: %v", err)
+		}
+	})
 }
 
-func (srv *server) waitExitSignal() {
-	c := make(chan os.Signal)
-	signal.Notify(c, syscall.SIGTERM)
-	signal.Notify(c, syscall.SIGINT)
-	<-c
+// processKickRequests closes sessions of accounts kicked by auth server on duplicate login or banned.
+// Only the session which online mark was requested to kick is closed, never a newer one of the account.
+func (srv *server) processKickRequests() {
+	requests, err := srv.onlineRepo.GetKickRequests(srv.config.World.RealmID)
+	if err != nil {
+		srv.logger.Errorf("can not get kick requests: %v", err)
+		return
+	}
+
+	for _, r := range requests {
+		if s := srv.sessions.find(r.SessionID); s != nil {
+			s.getLogger().Infof("world session kicked on request")
+			s.close()
+		} else if err := srv.onlineRepo.SetOffline(r.AccountID, r.SessionID); err != nil {
+			srv.logger.Errorf("can not mark account %v offline: %v", r.AccountID, err)
+		}
+	}
+}
+
+func (srv *server) runHeartbeatLoop() {
+	ticker := time.NewTicker(srv.config.World.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			players := uint32(srv.sessions.count())
+			if err := srv.statusRepo.Heartbeat(srv.config.World.RealmID, players); err != nil {
+				srv.logger.Errorf("can not send heartbeat: %v", err)
+			}
+			srv.processKickRequests()
+		case <-srv.kicks:
+			srv.processKickRequests()
+		case <-srv.stopping:
+			return
+		}
+	}
 }
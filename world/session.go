@@ -1,48 +1,365 @@
 package world
 
 import (
-	"log"
+	"crypto/sha1"
+	"crypto/subtle"
+	"errors"
+	"go.uber.org/atomic"
+	"io"
 	xnet "net"
+	"strings"
+	"sync"
+	"time"
+	"xcore/config"
+	"xcore/core/logger"
+	"xcore/core/models"
 	"xcore/core/net"
+	"xcore/core/srp"
+	"xcore/utils"
 )
 
+var (
+	errUnexpectedOpcode = errors.New("unexpected world opcode")
+)
+
+const (
+	expansionTBC = 1
+
+	authedReadTimeout = time.Minute * 2
+)
+
+type sessionStatus uint8
+
+const (
+	authSessionStatus sessionStatus = 1 << iota
+	authedStatus                    // authorized, character is not in world
+	loggedInStatus                  // character is in world
+	transferStatus                  // character is transferring between maps
+	closedStatus
+
+	anyAuthedStatus = authedStatus | loggedInStatus | transferStatus
+)
+
+func (st sessionStatus) has(o sessionStatus) bool {
+	return st&o == o
+}
+
+func (st sessionStatus) String() string {
+	switch st {
+	case authSessionStatus:
+		return "auth session"
+	case authedStatus:
+		return "authed"
+	case loggedInStatus:
+		return "logged in"
+	case transferStatus:
+		return "transfer"
+	case closedStatus:
+		return "closed"
+	}
+	return "unknown"
+}
+
 type Session interface {
 	start()
 }
 
 type session struct {
-	sock *net.Socket
+	sock   *net.Socket
+	srv    *server
+	reader *net.WorldPacketReader
+
+	// mu guards account and loggers which are replaced on auth and read by console and other sessions,
+	// the session goroutine itself reads them without lock
+	mu           sync.RWMutex
+	logger       logger.Logger
+	packetLogger logger.Logger
+	account      *models.Account
+
+	id      string
+	status  atomic.Uint32 // sessionStatus, written by console and other sessions on kick
+	seed    uint32
+	latency uint32
 }
 
-func NewSession(c *xnet.TCPConn) Session {
-	sock := net.NewSocket(c)
-	return &session{
-		sock: sock,
+func newSession(id string, conn *xnet.TCPConn, srv *server) Session {
+	sock := net.NewSocket(conn)
+	s := &session{
+		sock:   sock,
+		srv:    srv,
+		reader: net.NewWorldPacketReader(sock),
+		id:     id,
 	}
+	s.setStatus(authSessionStatus)
+	s.logger = srv.logger.With("session", id, "remote", conn.RemoteAddr().String())
+	s.packetLogger = srv.packetLogger.With("session", id, "remote", conn.RemoteAddr().String())
+
+	sock.OnClose(func(err error) {
+		// socket may be closed from console or other session goroutine
+		if err != nil {
+			s.getLogger().Warnf("world session closed with error: %v", err)
+		} else {
+			s.getLogger().Infof("world session closed")
+		}
+	})
+	return s
+}
+
+func (s *session) getStatus() sessionStatus {
+	return sessionStatus(s.status.Load())
+}
+
+func (s *session) setStatus(st sessionStatus) {
+	s.status.Store(uint32(st))
+}
+
+// setAccount binds account to session and its log lines.
+func (s *session) setAccount(acc *models.Account) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.account = acc
+	s.logger = s.logger.With("account", acc.Name)
+	s.packetLogger = s.packetLogger.With("account", acc.Name)
+}
+
+// getAccount is safe to call from any goroutine.
+func (s *session) getAccount() *models.Account {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.account
+}
+
+// getLogger is safe to call from any goroutine.
+func (s *session) getLogger() logger.Logger {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.logger
 }
 
 func (s *session) start() {
+	s.logger.Infof("world session started")
+
+	// session is added to manager once its account is authorized
+	defer s.srv.sessions.remove(s)
+
 	if err := s.authorize(); err != nil {
-		log.Printf("can not authorize world session: %v", err)
+		s.logger.Warnf("can not authorize world session: %v", err)
+		s.close()
+		return
+	}
+
+	if s.getStatus() == closedStatus {
+		return
+	}
+
+	if err := s.srv.onlineRepo.SetOnline(s.account.ID, s.srv.config.World.RealmID, s.id); err != nil {
+		s.logger.Errorf("can not mark account online: %v", err)
+	}
+	defer s.setOffline()
+
+	if err := s.receiveLoop(); err != nil && err != io.EOF {
+		s.logger.Warnf("world session error: %v", err)
+	}
+	s.close()
+}
+
+func (s *session) setOffline() {
+	if err := s.srv.onlineRepo.SetOffline(s.account.ID, s.id); err != nil {
+		s.logger.Errorf("can not mark account offline: %v", err)
+	}
+}
+
+func (s *session) info() net.SessionInfo {
+	i := net.SessionInfo{
+		ID:         s.id,
+		RemoteAddr: s.sock.RemoteAddr(),
+		Status:     s.getStatus().String(),
+	}
+	if acc := s.getAccount(); acc != nil {
+		i.Account = acc.Name
+	}
+	return i
+}
+
+func (s *session) close() {
+	s.setStatus(closedStatus)
+	if err := s.sock.Close(); err != nil {
+		s.getLogger().Warnf("can not close world session: %v", err)
 	}
 }
 
 func (s *session) authorize() error {
-	if err := s.sock.ReceiveData(); err != nil {
+	if err := s.sendAuthChallenge(); err != nil {
+		return err
+	}
+
+	p, err := s.reader.Read()
+	if err != nil {
 		return err
 	}
 
-	build := s.sock.MustReadUInt32()
-	loginServerId := s.sock.MustReadUInt32()
-	accountName := s.sock.MustReadString()
-	challenge := s.sock.MustReadUInt32()
-	authHash := s.sock.MustReadBytes(20)
+	if p.Opcode != net.CMSG_AUTH_SESSION {
+		return errUnexpectedOpcode
+	}
+
+	payload, err := newAuthSession(p.Payload)
+	if err != nil {
+		return err
+	}
+
+	return s.handleAuthSession(payload)
+}
+
+func (s *session) receiveLoop() error {
+	s.sock.SetReadTimeout(authedReadTimeout)
 
-	log.Println(build)
-	log.Println(loginServerId)
-	log.Println(accountName)
-	log.Println(challenge)
-	log.Println(authHash)
+	for s.getStatus() != closedStatus {
+		p, err := s.reader.Read()
+		if err != nil {
+			return err
+		}
 
+		if err := s.handlePacket(p); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+func (s *session) handlePacket(p *net.WorldPacket) error {
+	s.packetLogger.Debugf("received opcode %v (%d bytes)", p.Opcode, len(p.Payload))
+
+	h := opcodeHandlers[p.Opcode]
+	if h == nil {
+		s.reportOpcode(p.Opcode, "unhandled")
+		return nil
+	}
+
+	if st := s.getStatus(); !h.status.has(st) {
+		s.logger.Warnf("received opcode %v in unexpected status %v, dropping", p.Opcode, st)
+		return nil
+	}
+
+	return s.runHandler(h, p)
+}
+
+func (s *session) runHandler(h *opcodeHandler, p *net.WorldPacket) error {
+	start := time.Now()
+	defer handlerDuration.ObserveSince(start, p.Opcode.String())
+	return h.handler(s, p)
+}
+
+func (s *session) sendAuthChallenge() error {
+	s.seed = uint32(srp.RandBigInt(32).Uint64())
+
+	s.sock.BeginWriteWorldPacket(net.SMSG_AUTH_CHALLENGE).
+		MustWriteUInt32(s.seed)
+
+	return s.sock.CommitWriteWorldPacket()
+}
+
+func (s *session) handleAuthSession(p *authSession) error {
+	if b := s.srv.config.FindClientBuild(uint16(p.build)); b == nil || !b.IsAccepted() || uint32(b.Build) != p.build {
+		s.logger.Infof("world session rejected client build %v", p.build)
+		return s.closeWithResult(authResultVersionMismatch)
+	}
+
+	acc, err := s.srv.getAccount(p.accountName)
+	if err != nil {
+		return err
+	}
+
+	if acc == nil {
+		return s.closeWithResult(authResultUnknownAccount)
+	}
+
+	if !acc.SessionKey.Valid {
+		return s.closeWithResult(authResultSessionExpired)
+	}
+
+	K := srp.SessionKeyBytes(acc.SessionKey.String)
+
+	h := sha1.New()
+	h.Write([]byte(strings.ToUpper(p.accountName)))
+	h.Write(utils.LittleEndian.UInt32ToBytes(0))
+	h.Write(utils.LittleEndian.UInt32ToBytes(p.clientSeed))
+	h.Write(utils.LittleEndian.UInt32ToBytes(s.seed))
+	h.Write(K)
+	expectedDigest := h.Sum(nil)
+
+	if subtle.ConstantTimeCompare(expectedDigest, p.digest[:]) == 0 {
+		return s.closeWithResult(authResultIncorrectPassword)
+	}
+
+	old, err := s.srv.sessions.add(s, acc, s.srv.config.DuplicateLoginPolicy == config.DuplicateLoginRejectNew)
+	switch err {
+	case errAlreadyOnline:
+		return s.closeWithResult(authResultAlreadyOnline)
+	case errSessionsClosed:
+		s.logger.Infof("world session closed, server is stopping")
+		s.close()
+		return nil
+	}
+	if old != nil {
+		old.getLogger().Infof("world session kicked by duplicate login")
+		old.close()
+	}
+
+	s.sock.SetHeaderCipher(net.NewHeaderCipher(K))
+
+	s.sock.BeginWriteWorldPacket(net.SMSG_AUTH_RESPONSE).
+		MustWriteByte(byte(authResultOK)).
+		MustWriteUInt32(0). // billing time remaining
+		MustWriteByte(0).   // billing plan flags
+		MustWriteUInt32(0). // billing time rested
+		MustWriteByte(expansionTBC)
+
+	if err := s.sock.CommitWriteWorldPacket(); err != nil {
+		return err
+	}
+
+	s.setStatus(authedStatus)
+	s.srv.logins.Add()
+	s.logger.Infof("world session authorized")
+	return s.sendMotd()
+}
+
+// sendMotd sends message of the day, lines are separated with `@` in config.
+func (s *session) sendMotd() error {
+	motd := s.srv.motd.Load()
+	if motd == "" {
+		return nil
+	}
+
+	lines := strings.Split(motd, "@")
+	s.sock.BeginWriteWorldPacket(net.SMSG_MOTD).
+		MustWriteUInt32(uint32(len(lines)))
+	for _, line := range lines {
+		s.sock.MustWriteBytes([]byte(line)).
+			MustWriteByte(0)
+	}
+
+	return s.sock.CommitWriteWorldPacket()
+}
+
+func (s *session) sendServerMessage(t net.ServerMessageType, text string) error {
+	s.sock.BeginWriteWorldPacket(net.SMSG_SERVER_MESSAGE).
+		MustWriteUInt32(uint32(t)).
+		MustWriteBytes([]byte(text)).
+		MustWriteByte(0)
+
+	return s.sock.CommitWriteWorldPacket()
+}
+
+func (s *session) closeWithResult(result authResult) error {
+	s.setStatus(closedStatus)
+
+	s.sock.BeginWriteWorldPacket(net.SMSG_AUTH_RESPONSE).
+		MustWriteByte(byte(result))
+
+	if err := s.sock.CommitWriteWorldPacket(); err != nil {
+		return err
+	}
+
+	return s.sock.Close()
+}
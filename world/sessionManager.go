@@ -0,0 +1,106 @@
+package world
+
+import (
+	"errors"
+	"sync"
+	"xcore/core/models"
+)
+
+var (
+	errSessionsClosed = errors.New("server is stopping")
+	errAlreadyOnline  = errors.New("account is already online")
+)
+
+type sessionManager struct {
+	mu       sync.RWMutex
+	sessions map[string]*session
+	closed   bool
+}
+
+func newSessionManager() *sessionManager {
+	return &sessionManager{
+		sessions: map[string]*session{},
+	}
+}
+
+// add sets account of authorized session and adds it, existing session of the account is looked up
+// under the same lock, so two sessions of one account can not be added concurrently.
+// Existing session is returned to be closed, unless rejectDuplicate is set and errAlreadyOnline is returned.
+// errSessionsClosed is returned if manager is closed and session must not be started.
+func (m *sessionManager) add(s *session, acc *models.Account, rejectDuplicate bool) (*session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil, errSessionsClosed
+	}
+
+	old := m.findByAccountLocked(acc.ID)
+	if old != nil && rejectDuplicate {
+		return nil, errAlreadyOnline
+	}
+	if old != nil {
+		delete(m.sessions, old.id)
+	}
+
+	s.setAccount(acc)
+	m.sessions[s.id] = s
+	sessionsActive.Set(float64(len(m.sessions)))
+	return old, nil
+}
+
+func (m *sessionManager) remove(s *session) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.sessions, s.id)
+	sessionsActive.Set(float64(len(m.sessions)))
+}
+
+func (m *sessionManager) findByAccount(accountID uint) *session {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.findByAccountLocked(accountID)
+}
+
+// findByAccountLocked has to be called with mu locked.
+func (m *sessionManager) findByAccountLocked(accountID uint) *session {
+	for _, s := range m.sessions {
+		if acc := s.getAccount(); acc != nil && acc.ID == accountID {
+			return s
+		}
+	}
+	return nil
+}
+
+func (m *sessionManager) count() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.sessions)
+}
+
+func (m *sessionManager) forEach(f func(s *session)) {
+	m.mu.RLock()
+	sessions := make([]*session, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		sessions = append(sessions, s)
+	}
+	m.mu.RUnlock()
+
+	for _, s := range sessions {
+		f(s)
+	}
+}
+
+func (m *sessionManager) find(id string) *session {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.sessions[id]
+}
+
+// closeAll closes active sessions and rejects sessions added later.
+func (m *sessionManager) closeAll() {
+	m.mu.Lock()
+	m.closed = true
+	m.mu.Unlock()
+
+	m.forEach((*session).close)
+}
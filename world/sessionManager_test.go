@@ -0,0 +1,96 @@
+package world
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"xcore/config"
+	"xcore/core/logger"
+	"xcore/core/models"
+)
+
+func newTestSessions(t *testing.T, n int) []*session {
+	l, err := logger.NewWithWriter(&config.LogConfig{Level: "error"}, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessions := make([]*session, n)
+	for i := range sessions {
+		sessions[i] = &session{id: fmt.Sprint(i), logger: l, packetLogger: l}
+	}
+	return sessions
+}
+
+// addConcurrently adds sessions of one account at once and returns results of add.
+func addConcurrently(m *sessionManager, sessions []*session, rejectDuplicate bool) ([]*session, []error) {
+	acc := &models.Account{Name: "PLAYER"}
+	acc.ID = 1
+
+	olds := make([]*session, len(sessions))
+	errs := make([]error, len(sessions))
+	var wg sync.WaitGroup
+	for i, s := range sessions {
+		wg.Add(1)
+		go func(i int, s *session) {
+			defer wg.Done()
+			olds[i], errs[i] = m.add(s, acc, rejectDuplicate)
+		}(i, s)
+	}
+	wg.Wait()
+	return olds, errs
+}
+
+func TestSessionManagerRejectsConcurrentDuplicate(t *testing.T) {
+	m := newSessionManager()
+	_, errs := addConcurrently(m, newTestSessions(t, 50), true)
+
+	added := 0
+	for _, err := range errs {
+		switch err {
+		case nil:
+			added++
+		case errAlreadyOnline:
+		default:
+			t.Fatal(err)
+		}
+	}
+	if added != 1 || m.count() != 1 {
+		t.Fatalf("got %v sessions added and %v in manager, want 1", added, m.count())
+	}
+}
+
+func TestSessionManagerReplacesConcurrentDuplicate(t *testing.T) {
+	m := newSessionManager()
+	olds, errs := addConcurrently(m, newTestSessions(t, 50), false)
+
+	replaced := map[*session]bool{}
+	for i, err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if old := olds[i]; old != nil {
+			if replaced[old] {
+				t.Fatalf("session %v replaced twice", old.id)
+			}
+			replaced[old] = true
+		}
+	}
+	// every session but the last one added is returned to be kicked
+	if len(replaced) != 49 || m.count() != 1 {
+		t.Fatalf("got %v sessions replaced and %v in manager, want 49 and 1", len(replaced), m.count())
+	}
+	if s := m.findByAccount(1); s == nil || replaced[s] {
+		t.Fatal("remaining session of account was replaced")
+	}
+}
+
+func TestSessionManagerClosedRejectsSessions(t *testing.T) {
+	m := newSessionManager()
+	m.closeAll()
+
+	if _, err := m.add(newTestSessions(t, 1)[0], &models.Account{}, false); err != errSessionsClosed {
+		t.Fatalf("got %v, want %v", err, errSessionsClosed)
+	}
+}